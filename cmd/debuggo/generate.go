@@ -1,29 +1,38 @@
 package main
 
 import (
+	"path/filepath"
+
+	"github.com/negrel/debuggo/internal/config"
 	"github.com/negrel/debuggo/internal/generator"
 	"github.com/urfave/cli"
 )
 
 // generate command
 var generate = cli.Command{
-	Name:        "generate",
-	ShortName:   "gen",
-	Usage:       "Generate debugging package.",
-	UsageText:   "debuggo generate ",
-	Description: "Generate an optimized version (for production) of the given debugging package.",
+	Name:      "generate",
+	ShortName: "gen",
+	Usage:     "Generate debugging package.",
+	UsageText: "debuggo generate [--config FILE] [package...]\n   debuggo generate --src-dir DIR [--src-file FILE] [--cmn-dir DIR] [--tags TAG...] [--out-dir DIR]",
+	Description: `Generate the packages defined in the configuration file (default to all), or
+	 an optimized version (for production) of the debugging package of the source
+	 directory if --src-dir is set.`,
 	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:      "config",
+			Usage:     "path to the configuration file.",
+			Value:     config.FileName,
+			TakesFile: true,
+		},
 		cli.StringFlag{
 			Name:      "src-dir",
 			Usage:     "path to the directory that contains source packages.",
 			TakesFile: true,
-			Required:  true,
 		},
 		cli.StringFlag{
 			Name:      "src-file",
-			Usage:     "path to a Go file.",
+			Usage:     "path to a Go file of the source directory, other files are ignored.",
 			TakesFile: true,
-			Required:  true,
 		},
 		cli.StringFlag{
 			Name:      "cmn-dir",
@@ -32,7 +41,7 @@ var generate = cli.Command{
 		},
 		cli.StringSliceFlag{
 			Name:      "tags",
-			Usage:     "build flags is a list of command-line flags to be passed through to the build system's query tool.",
+			Usage:     "build tags that enable the debug variant, the prod variant is used otherwise.",
 			TakesFile: true,
 		},
 		cli.StringFlag{
@@ -43,21 +52,54 @@ var generate = cli.Command{
 		},
 	},
 	Action: func(ctx *cli.Context) error {
-		srcDir := ctx.String("src-dir")
-		outDir := ctx.String("out-dir")
-		commonDir := ctx.String("cmn-dir")
-
-		options := make([]generator.Option, 0, 3)
-		options = append(options, generator.SrcDir(srcDir), generator.OutputDir(outDir))
-		if commonDir != "" {
-			options = append(options, generator.CommonDir(commonDir))
-		}
-
-		gen, err := generator.New(options...)
+		generators, err := generators(ctx)
 		if err != nil {
 			return err
 		}
 
-		return gen.Start()
+		for _, gen := range generators {
+			err = gen.Start()
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
 	},
 }
+
+func generators(ctx *cli.Context) ([]*generator.Generator, error) {
+	srcDir := ctx.String("src-dir")
+	if srcDir == "" {
+		cfg, err := config.Load(ctx.String("config"))
+		if err != nil {
+			return nil, err
+		}
+
+		return cfg.Generators(ctx.Args()...)
+	}
+
+	outDir := ctx.String("out-dir")
+	srcFile := ctx.String("src-file")
+	commonDir := ctx.String("cmn-dir")
+	tags := ctx.StringSlice("tags")
+
+	options := make([]generator.Option, 0, 5)
+	options = append(options, generator.SrcDir(srcDir), generator.OutputDir(outDir))
+	if srcFile != "" {
+		options = append(options, generator.Include(filepath.Base(srcFile)))
+	}
+	if commonDir != "" {
+		options = append(options, generator.CommonDir(commonDir))
+	}
+	if len(tags) != 0 {
+		options = append(options, generator.Tags(tags...))
+	}
+
+	gen, err := generator.New(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*generator.Generator{gen}, nil
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/negrel/debuggo/internal/assertgen"
 	"github.com/urfave/cli"
 )
 
 func main() {
+	assertgen.RegisterPasses()
+
 	app := cli.NewApp()
 	app.Name = "Debuggo"
 	app.Usage = "Optimized debugging package generator."
@@ -24,10 +27,17 @@ func main() {
 	}
 	app.Commands = []cli.Command{
 		generate,
+		overlayCmd,
+		vet,
 	}
 	app.EnableBashCompletion = true
 	app.ExitErrHandler = func(context *cli.Context, err error) {
-		_, _ = fmt.Fprint(os.Stderr, err)
+		if err == nil {
+			return
+		}
+
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 
 	_ = app.Run(os.Args)
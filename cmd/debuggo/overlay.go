@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/negrel/debuggo/internal/overlay"
+	"github.com/urfave/cli"
+)
+
+// overlay command
+var overlayCmd = cli.Command{
+	Name:      "overlay",
+	Usage:     "Strip debuggo calls from a module for go build -overlay.",
+	UsageText: "debuggo overlay [--dir DIR] [--out-dir DIR] [--tags TAG...]",
+	Description: `Write copies of the module source files without the calls to the disabled
+	 functions of the debuggo packages, and an overlay file replacing the source files
+	 with their copies. Build with "go build -overlay <overlay file>" so the arguments of
+	 the stripped calls are never evaluated.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:      "dir",
+			Usage:     "root directory of the module.",
+			Value:     ".",
+			TakesFile: true,
+		},
+		cli.StringFlag{
+			Name:      "out-dir",
+			Usage:     "output directory of the stripped files and of the overlay file.",
+			Value:     ".debuggo",
+			TakesFile: true,
+		},
+		cli.StringSliceFlag{
+			Name:  "tags",
+			Usage: "build tags of the build, calls enabled by the tags are kept.",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		gen, err := overlay.New(
+			overlay.Dir(ctx.String("dir")),
+			overlay.OutputDir(ctx.String("out-dir")),
+			overlay.Tags(ctx.StringSlice("tags")...),
+		)
+		if err != nil {
+			return err
+		}
+
+		overlayFile, err := gen.Start()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("go build -overlay %v\n", overlayFile)
+		return nil
+	},
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/negrel/debuggo/internal/analysis/checker"
+	"github.com/negrel/debuggo/internal/analysis/nilcheck"
+	"github.com/negrel/debuggo/internal/analysis/sideeffect"
+	"github.com/negrel/debuggo/internal/analysis/terminate"
+	"github.com/urfave/cli"
+	"golang.org/x/tools/go/analysis"
+)
+
+// analyzers run by the vet command.
+var analyzers = []*analysis.Analyzer{
+	nilcheck.Analyzer,
+	sideeffect.Analyzer,
+	terminate.Analyzer,
+}
+
+// vet command
+var vet = cli.Command{
+	Name:      "vet",
+	Usage:     "Report suspicious uses of the debuggo packages.",
+	UsageText: "debuggo vet [--tags TAG...] [--fix] [packages]",
+	Description: `Analyze the given packages (default to ./...) and their tests, and report
+	 the uses of the debuggo packages that behave differently across builds.`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "tags",
+			Usage: "build tags used to load the packages.",
+		},
+		cli.BoolFlag{
+			Name:  "fix",
+			Usage: "apply the suggested fixes.",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		patterns := []string(ctx.Args())
+		if len(patterns) == 0 {
+			patterns = []string{"./..."}
+		}
+
+		c, err := checker.New(".", ctx.StringSlice("tags"), analyzers...)
+		if err != nil {
+			return err
+		}
+
+		diagnostics, err := c.Run(patterns...)
+		if err != nil {
+			return err
+		}
+
+		for _, diagnostic := range diagnostics {
+			fmt.Printf("%v: %v (%v)\n", diagnostic.Position, diagnostic.Message, diagnostic.Analyzer)
+		}
+
+		if ctx.Bool("fix") {
+			skipped, err := c.ApplyFixes(diagnostics)
+			for _, diagnostic := range skipped {
+				fmt.Printf("%v: fix skipped, it conflicts with another fix (%v)\n", diagnostic.Position, diagnostic.Analyzer)
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(skipped) != 0 {
+				return fmt.Errorf("%v conflicting fix(es) skipped", len(skipped))
+			}
+			return nil
+		}
+
+		if len(diagnostics) != 0 {
+			return fmt.Errorf("%v issue(s) found", len(diagnostics))
+		}
+
+		return nil
+	},
+}
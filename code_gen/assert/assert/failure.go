@@ -0,0 +1,164 @@
+//go:build ignore
+// +build ignore
+
+package assert
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Failure describes a failed assertion. It's the panic payload of the
+// PanicHandler.
+type Failure struct {
+	// Assertion is the name of the failed assertion (e.g. "Equal").
+	Assertion string
+	// CallSite is the file:line of the assertion call.
+	CallSite string
+	// Trace is the file:line of each stack frame leading to the assertion
+	// call, see CallerInfo.
+	Trace []string
+	// Expected and Actual are the compared values of the assertions that
+	// compare an expected and an actual value (e.g. Equal), nil otherwise.
+	Expected interface{}
+	Actual   interface{}
+	// Diff is the unified diff of the expected and actual values, if any.
+	Diff string
+	// Description describes why the assertion failed.
+	Description string
+	// Message is the user message of the assertion.
+	Message string
+	// Prefix is the message prefix of the Assertions object that reported
+	// the failure.
+	Prefix string
+}
+
+// Error implements the error interface, it returns the failure description
+// laid out as testify does, preceded by a line with the prefix if it's set.
+func (f *Failure) Error() string {
+	content := []labeledContent{
+		{"Error Trace", strings.Join(f.Trace, "\n\t\t\t")},
+		{"Error", f.Description},
+	}
+
+	if len(f.Message) > 0 {
+		content = append(content, labeledContent{"Messages", f.Message})
+	}
+
+	output := labeledOutput(content...)
+	if f.Prefix == "" {
+		return output
+	}
+
+	return f.Prefix + "\n" + output
+}
+
+// FailureHandler handles the failures reported by the assertions.
+type FailureHandler func(failure *Failure)
+
+// SetFailureHandler sets the failure handler of the package-level assertions.
+// A nil handler restores the default PanicHandler.
+func SetFailureHandler(handler FailureHandler) {
+	std.setFailureHandler(handler)
+}
+
+// PanicHandler panics with the failure, it's the default failure handler.
+func PanicHandler(failure *Failure) {
+	panic(failure)
+}
+
+// LogHandler returns a FailureHandler that logs the failures using the given
+// logger and continue the execution. The standard logger is used if logger is
+// nil.
+func LogHandler(logger *log.Logger) FailureHandler {
+	return func(failure *Failure) {
+		logFailure(logger, failure)
+	}
+}
+
+// exit is the function called by the ExitHandler handlers to exit.
+var exit = struct {
+	sync.Mutex
+	fn func(code int)
+}{fn: os.Exit}
+
+// SetExitFunc sets the function called by the ExitHandler handlers to exit the
+// program, os.Exit by default. The assertion returns if it returns, so the
+// code using an ExitHandler can be tested.
+func SetExitFunc(fn func(code int)) {
+	exit.Lock()
+	defer exit.Unlock()
+
+	exit.fn = fn
+}
+
+// ExitHandler returns a FailureHandler that logs the failures using the given
+// logger and then terminates the program with the given status code, see
+// SetExitFunc. The standard logger is used if logger is nil.
+func ExitHandler(logger *log.Logger, code int) FailureHandler {
+	return func(failure *Failure) {
+		logFailure(logger, failure)
+
+		exit.Lock()
+		fn := exit.fn
+		exit.Unlock()
+
+		fn(code)
+	}
+}
+
+// ChanHandler returns a FailureHandler that sends the failures to the given
+// channel. Assertions block until the failure is received if the channel is
+// full.
+func ChanHandler(ch chan<- *Failure) FailureHandler {
+	return func(failure *Failure) {
+		ch <- failure
+	}
+}
+
+func logFailure(logger *log.Logger, failure *Failure) {
+	if logger == nil {
+		log.Print(failure.Error())
+		return
+	}
+
+	logger.Print(failure.Error())
+}
+
+// reportFailure reports the failure of an assertion to the TestingT. It
+// replaces the t.Errorf call of Fail.
+func reportFailure(t TestingT, description, message string) {
+	if call, isCall := t.(*assertionCall); isCall {
+		call.fail(description, message)
+		return
+	}
+
+	t.Errorf("\n%s", labeledOutput(
+		labeledContent{"Error Trace", strings.Join(CallerInfo(), "\n\t\t\t")},
+		labeledContent{"Error", description},
+		labeledContent{"Messages", message},
+	))
+}
+
+// callSite returns the file:line of the first caller outside of this package.
+func callSite() string {
+	pkgPath := reflect.TypeOf(Failure{}).PkgPath()
+
+	pc := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(2, pc)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, pkgPath+".") {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,38 @@
+//go:build debuggo_assert
+// +build debuggo_assert
+
+package golden
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/negrel/debuggo/pkg/assert"
+)
+
+func TestExitHandler(t *testing.T) {
+	code := -1
+	assert.SetExitFunc(func(c int) {
+		code = c
+	})
+	defer assert.SetExitFunc(os.Exit)
+
+	var output bytes.Buffer
+	a := assert.New(assert.WithFailureHandler(assert.ExitHandler(log.New(&output, "", 0), 3)))
+
+	a.True(true)
+	if code != -1 {
+		t.Errorf("exited with code %v after a successful assertion", code)
+	}
+
+	a.True(false, "should be true")
+	if code != 3 {
+		t.Errorf("exit code %v, want 3", code)
+	}
+	if !strings.Contains(output.String(), "Messages:   \tshould be true") {
+		t.Errorf("the failure isn't logged before exiting:\n%v", output.String())
+	}
+}
@@ -0,0 +1,154 @@
+//go:build debuggo_assert
+// +build debuggo_assert
+
+// Package golden checks the rendered failure text of a set of failing
+// assertions against failures.golden, run it with the debuggo_assert tag:
+//
+//	go test -tags debuggo_assert ./code_gen/assert/golden
+//
+// The -update flag rewrites the golden file after an intended change.
+package golden
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/negrel/debuggo/pkg/assert"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+var update = flag.Bool("update", false, "update the golden file instead of comparing it.")
+
+const goldenFile = "failures.golden"
+
+type point struct {
+	X, Y int
+}
+
+var cases = []struct {
+	name   string
+	assert func(a *assert.Assertions)
+}{
+	{"True without message", func(a *assert.Assertions) {
+		a.True(false)
+	}},
+	{"True with message", func(a *assert.Assertions) {
+		a.True(false, "should be true")
+	}},
+	{"True with formatted message", func(a *assert.Assertions) {
+		a.True(false, "expected %d to be %v", 1, true)
+	}},
+	{"Truef", func(a *assert.Assertions) {
+		a.Truef(false, "expected %d to be %v", 1, true)
+	}},
+	{"Equal strings", func(a *assert.Assertions) {
+		a.Equal("hello", "world", "greeting")
+	}},
+	{"Equal structs", func(a *assert.Assertions) {
+		a.Equal(point{1, 2}, point{1, 3})
+	}},
+	{"Nil", func(a *assert.Assertions) {
+		a.Nil(errors.New("unexpected error"), "err of %v", "getWeather")
+	}},
+	{"Greater", func(a *assert.Assertions) {
+		a.Greater(1, 2, "message %s", "forwarded")
+	}},
+	{"IsIncreasing", func(a *assert.Assertions) {
+		a.IsIncreasing([]int{1, 3, 2}, "message %s", "forwarded")
+	}},
+	{"Len", func(a *assert.Assertions) {
+		a.Len([]int{1, 2}, 3)
+	}},
+	{"NotContains", func(a *assert.Assertions) {
+		a.NotContains([]int{1, 2}, 2)
+	}},
+	{"Fail", func(a *assert.Assertions) {
+		a.Fail("failure message", "user message")
+	}},
+	{"Failf", func(a *assert.Assertions) {
+		a.Failf("failure message", "user message %v", 42)
+	}},
+}
+
+var packageCases = []struct {
+	name   string
+	assert func()
+}{
+	{"package True with formatted message", func() {
+		assert.True(false, "expected %d to be %v", 1, true)
+	}},
+	{"package Greater", func() {
+		assert.Greater(1, 2, "message %s", "forwarded")
+	}},
+	{"package Failf", func() {
+		assert.Failf("failure message", "user message %v", 42)
+	}},
+}
+
+func TestFailures(t *testing.T) {
+	var failure *assert.Failure
+	handler := func(f *assert.Failure) {
+		failure = f
+	}
+
+	var output bytes.Buffer
+	a := assert.New(assert.WithPrefix("[golden]"), assert.WithFailureHandler(handler))
+	for _, c := range cases {
+		failure = nil
+		c.assert(a)
+		writeFailure(&output, c.name, failure)
+	}
+
+	assert.SetFailureHandler(handler)
+	defer assert.SetFailureHandler(assert.PanicHandler)
+	for _, c := range packageCases {
+		failure = nil
+		c.assert()
+		writeFailure(&output, c.name, failure)
+	}
+
+	if *update {
+		err := ioutil.WriteFile(goldenFile, output.Bytes(), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	expected, err := ioutil.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(expected, output.Bytes()) {
+		return
+	}
+
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(expected)),
+		B:        difflib.SplitLines(output.String()),
+		FromFile: goldenFile,
+		ToFile:   "rendered failures",
+		Context:  3,
+	})
+	t.Errorf("rendered failures differ from the golden file, run with -update if the change is expected:\n%v", diff)
+}
+
+// writeFailure writes the name of the case followed by the rendered failure.
+func writeFailure(w io.Writer, name string, failure *assert.Failure) {
+	fmt.Fprintf(w, "=== %v\n", name)
+	if failure == nil {
+		fmt.Fprint(w, "no failure\n\n")
+		return
+	}
+
+	// Only keep the call site, the rest of the trace depends on the runtime.
+	failure.Trace = []string{filepath.Base(failure.CallSite)}
+	fmt.Fprintf(w, "%v\n", failure)
+}
@@ -0,0 +1,185 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// blockingWriter is a writer whose writes wait until it's released.
+type blockingWriter struct {
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	started chan struct{}
+	release chan struct{}
+}
+
+func newBlockingWriter() *blockingWriter {
+	return &blockingWriter{
+		started: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	select {
+	case w.started <- struct{}{}:
+	default:
+	}
+	<-w.release
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Write(p)
+}
+
+func (w *blockingWriter) lines() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return strings.Fields(w.buf.String())
+}
+
+// fill writes the entry "0" and waits until the background goroutine blocks
+// on it, then writes the entries 1 to n.
+func fill(t *testing.T, a *AsyncWriter, w *blockingWriter, n int) {
+	t.Helper()
+
+	_, _ = a.Write([]byte("0\n"))
+	<-w.started
+	for i := 1; i <= n; i++ {
+		if _, err := fmt.Fprintf(a, "%d\n", i); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAsyncWriterDropNewest(t *testing.T) {
+	w := newBlockingWriter()
+	a := NewAsyncWriter(w, 2, DropNewest)
+
+	fill(t, a, w, 4)
+	close(w.release)
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := strings.Join(w.lines(), " "), "0 1 2"; got != want {
+		t.Errorf("written entries are %q, want %q", got, want)
+	}
+	if got := a.Dropped(); got != 2 {
+		t.Errorf("dropped %v entries, want 2", got)
+	}
+}
+
+func TestAsyncWriterDropOldest(t *testing.T) {
+	w := newBlockingWriter()
+	a := NewAsyncWriter(w, 2, DropOldest)
+
+	fill(t, a, w, 4)
+	close(w.release)
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := strings.Join(w.lines(), " "), "0 3 4"; got != want {
+		t.Errorf("written entries are %q, want %q", got, want)
+	}
+	if got := a.Dropped(); got != 2 {
+		t.Errorf("dropped %v entries, want 2", got)
+	}
+}
+
+func TestAsyncWriterBlock(t *testing.T) {
+	w := newBlockingWriter()
+	a := NewAsyncWriter(w, 2, Block)
+
+	_, _ = a.Write([]byte("0\n"))
+	<-w.started
+
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		for i := 1; i <= 4; i++ {
+			_, _ = fmt.Fprintf(a, "%d\n", i)
+		}
+	}()
+
+	close(w.release)
+	<-written
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := strings.Join(w.lines(), " "), "0 1 2 3 4"; got != want {
+		t.Errorf("written entries are %q, want %q", got, want)
+	}
+	if got := a.Dropped(); got != 0 {
+		t.Errorf("dropped %v entries, want 0", got)
+	}
+}
+
+func TestAsyncWriterConcurrentWrites(t *testing.T) {
+	for _, policy := range []OverflowPolicy{Block, DropNewest, DropOldest} {
+		var buf bytes.Buffer
+		a := NewAsyncWriter(&buf, 4, policy)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					_, _ = a.Write([]byte("entry\n"))
+				}
+			}()
+		}
+		wg.Wait()
+
+		if err := a.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if got := uint64(strings.Count(buf.String(), "\n")) + a.Dropped(); got != 800 {
+			t.Errorf("policy %v: written and dropped entries are %v, want 800", policy, got)
+		}
+		if err := a.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAsyncWriterMinimumSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		var buf bytes.Buffer
+		a := NewAsyncWriter(&buf, size, DropOldest)
+		if got := cap(a.queue); got != 1 {
+			t.Errorf("queue size of %v is %v, want 1", size, got)
+		}
+
+		_, _ = a.Write([]byte("entry\n"))
+		if err := a.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+type errorWriter struct{}
+
+func (errorWriter) Write([]byte) (int, error) { return 0, errors.New("write error") }
+
+func TestAsyncWriterErrors(t *testing.T) {
+	a := NewAsyncWriter(errorWriter{}, 1, Block)
+	_, _ = a.Write([]byte("entry\n"))
+
+	if err := a.Flush(); err == nil || err.Error() != "write error" {
+		t.Errorf("Flush returned %v, want the write error", err)
+	}
+	if err := a.Close(); err != nil {
+		t.Errorf("Close returned %v, want nil", err)
+	}
+	if _, err := a.Write([]byte("entry\n")); err != ErrClosed {
+		t.Errorf("Write after Close returned %v, want ErrClosed", err)
+	}
+}
@@ -5,12 +5,12 @@ import (
 )
 
 func main() {
-	log.Traceln("[TRACE] - Trace log")
-	log.Debugln("[DEBUG] - Debug log")
-	log.Infoln("[INFO] - Info log")
-	log.Warnln("[WARN] - Warning log")
-	log.Errorln("[ERROR] - Error log")
-	log.Fatalln("[FATAL] - Fatal log")
+	log.Traceln("Trace log")
+	log.Debugln("Debug log")
+	log.Infoln("Info log")
+	log.Warnln("Warning log")
+	log.Errorln("Error log")
+	log.Fatalln("Fatal log")
 	// Will never be called because of log.Fatal
-	log.Panicln("[PANIC] - Panic log")
+	log.Panicln("Panic log")
 }
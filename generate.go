@@ -0,0 +1,7 @@
+// Package debuggo contains debugging packages that leverage the power of
+// conditional compilation, see the assert and log packages.
+//
+// The packages are generated from the debuggo.yaml configuration file.
+package debuggo
+
+//go:generate go run ./cmd/debuggo generate
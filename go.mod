@@ -1,19 +1,25 @@
 module github.com/negrel/debuggo
 
-go 1.15
+go 1.22.0
 
 require (
-	github.com/AlecAivazis/survey/v2 v2.1.1 // indirect
-	github.com/Masterminds/semver/v3 v3.1.0 // indirect
 	github.com/davecgh/go-spew v1.1.1
-	github.com/mattn/go-colorable v0.1.8 // indirect
-	github.com/mgutz/ansi v0.0.0-20200706080929-d51e80ef957d // indirect
 	github.com/negrel/asttk v0.0.0-20201023213657-e9c55de06520
-	github.com/oligot/go-mod-upgrade v0.2.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0
 	github.com/urfave/cli v1.22.4
-	golang.org/x/crypto v0.0.0-20201016220609-9e8e0b390897 // indirect
-	golang.org/x/sys v0.0.0-20201022201747-fb209a7c41cd // indirect
-	golang.org/x/text v0.3.3 // indirect
+	golang.org/x/tools v0.30.0
 	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776
 )
+
+require (
+	github.com/AlecAivazis/survey/v2 v2.1.1 // indirect
+	github.com/Masterminds/semver/v3 v3.1.0 // indirect
+	github.com/cpuguy83/go-md2man/v2 v2.0.0-20190314233015-f79a8a8ca69d // indirect
+	github.com/mattn/go-colorable v0.1.8 // indirect
+	github.com/mgutz/ansi v0.0.0-20200706080929-d51e80ef957d // indirect
+	github.com/oligot/go-mod-upgrade v0.2.1 // indirect
+	github.com/russross/blackfriday/v2 v2.0.1 // indirect
+	github.com/shurcooL/sanitized_anchor_name v1.0.0 // indirect
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+)
@@ -0,0 +1,141 @@
+// Package checker runs analyzers on the packages of a module.
+//
+// Packages and their tests are loaded with go/packages and analyzed by the
+// checker of golang.org/x/tools, analyzers can use facts.
+package checker
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	driver "golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+// Diagnostic is a diagnostic reported by an analyzer.
+type Diagnostic struct {
+	analysis.Diagnostic
+	// Analyzer is the name of the analyzer that reported the diagnostic.
+	Analyzer string
+	// Position is the position of the diagnostic.
+	Position token.Position
+}
+
+// Checker runs analyzers on packages.
+type Checker struct {
+	dir       string
+	tags      []string
+	analyzers []*analysis.Analyzer
+	fset      *token.FileSet
+}
+
+// New returns a Checker that runs the given analyzers, and their requirements,
+// on the packages of the module in the given directory. Packages are loaded
+// using the given build tags.
+func New(dir string, tags []string, analyzers ...*analysis.Analyzer) (*Checker, error) {
+	if err := analysis.Validate(analyzers); err != nil {
+		return nil, err
+	}
+
+	return &Checker{
+		dir:       dir,
+		tags:      tags,
+		analyzers: analyzers,
+		fset:      token.NewFileSet(),
+	}, nil
+}
+
+// FileSet returns the file set of the loaded packages.
+func (c *Checker) FileSet() *token.FileSet {
+	return c.fset
+}
+
+// Run analyzes the packages matching the given patterns, and their tests, and
+// returns the diagnostics sorted by position.
+func (c *Checker) Run(patterns ...string) ([]Diagnostic, error) {
+	pkgs, err := c.load(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	graph, err := driver.Analyze(c.analyzers, pkgs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The files of a package are also part of its test variant, their
+	// diagnostics are reported once. So are the identical diagnostics of
+	// analyzers checking the same mistake, by the first of them.
+	type key struct {
+		pos     token.Position
+		message string
+	}
+	reported := make(map[key]struct{})
+
+	diagnostics := make([]Diagnostic, 0)
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			return nil, fmt.Errorf("%v: %v: %v", act.Package.PkgPath, act.Analyzer.Name, act.Err)
+		}
+
+		for _, diagnostic := range act.Diagnostics {
+			position := c.fset.Position(diagnostic.Pos)
+			k := key{position, diagnostic.Message}
+			if _, isReported := reported[k]; isReported {
+				continue
+			}
+			reported[k] = struct{}{}
+
+			diagnostics = append(diagnostics, Diagnostic{
+				Diagnostic: diagnostic,
+				Analyzer:   act.Analyzer.Name,
+				Position:   position,
+			})
+		}
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		a, b := diagnostics[i].Position, diagnostics[j].Position
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+
+		return a.Offset < b.Offset
+	})
+
+	return diagnostics, nil
+}
+
+// load loads the packages matching the given patterns, with their tests and
+// the syntax of their dependencies.
+func (c *Checker) load(patterns []string) ([]*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode:  packages.LoadAllSyntax,
+		Dir:   c.dir,
+		Fset:  c.fset,
+		Tests: true,
+	}
+	if len(c.tags) != 0 {
+		cfg.BuildFlags = []string{"-tags", strings.Join(c.tags, ",")}
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []string
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, err := range pkg.Errors {
+			errs = append(errs, err.Error())
+		}
+	})
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("can't load the packages:\n%v", strings.Join(errs, "\n"))
+	}
+
+	return pkgs, nil
+}
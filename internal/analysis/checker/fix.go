@@ -0,0 +1,119 @@
+package checker
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"sort"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// ApplyFixes applies the first suggested fix of each diagnostic to the source
+// files, in the order of the diagnostics. The edits identical to the edits of
+// a previous fix are applied once. A fix with an edit overlapping the edits of
+// a previous fix is skipped, the diagnostics of the skipped fixes are
+// returned.
+func (c *Checker) ApplyFixes(diagnostics []Diagnostic) ([]Diagnostic, error) {
+	var skipped []Diagnostic
+	edits := make(map[string][]analysis.TextEdit)
+	for _, diagnostic := range diagnostics {
+		if len(diagnostic.SuggestedFixes) == 0 {
+			continue
+		}
+
+		fixEdits := make(map[string][]analysis.TextEdit)
+		conflict := false
+		for _, edit := range diagnostic.SuggestedFixes[0].TextEdits {
+			if !edit.End.IsValid() {
+				edit.End = edit.Pos
+			}
+
+			filename := c.fset.Position(edit.Pos).Filename
+			isDuplicate := false
+			for _, applied := range edits[filename] {
+				switch {
+				case isSameEdit(edit, applied):
+					isDuplicate = true
+				case overlap(edit, applied):
+					conflict = true
+				}
+			}
+
+			if !isDuplicate {
+				fixEdits[filename] = append(fixEdits[filename], edit)
+			}
+		}
+
+		if conflict {
+			skipped = append(skipped, diagnostic)
+			continue
+		}
+
+		for filename, fileEdits := range fixEdits {
+			edits[filename] = append(edits[filename], fileEdits...)
+		}
+	}
+
+	for filename, fileEdits := range edits {
+		err := c.applyEdits(filename, fileEdits)
+		if err != nil {
+			return skipped, fmt.Errorf("%v: %v", filename, err)
+		}
+	}
+
+	return skipped, nil
+}
+
+func isSameEdit(a, b analysis.TextEdit) bool {
+	return a.Pos == b.Pos && a.End == b.End && bytes.Equal(a.NewText, b.NewText)
+}
+
+// overlap reports whether the given edits can't be both applied: they replace
+// overlapping ranges, or insert text at the same position.
+func overlap(a, b analysis.TextEdit) bool {
+	if a.Pos == a.End && b.Pos == b.End {
+		return a.Pos == b.Pos
+	}
+
+	return a.Pos < b.End && b.Pos < a.End
+}
+
+func (c *Checker) applyEdits(filename string, edits []analysis.TextEdit) error {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	// Insertions come before the replacements starting at the same position.
+	sort.Slice(edits, func(i, j int) bool {
+		if edits[i].Pos != edits[j].Pos {
+			return edits[i].Pos < edits[j].Pos
+		}
+		return edits[i].End < edits[j].End
+	})
+
+	result := make([]byte, 0, len(src))
+	offset := 0
+	for _, edit := range edits {
+		begin, end := c.offset(edit.Pos), c.offset(edit.End)
+
+		result = append(result, src[offset:begin]...)
+		result = append(result, edit.NewText...)
+		offset = end
+	}
+	result = append(result, src[offset:]...)
+
+	formatted, err := format.Source(result)
+	if err != nil {
+		return fmt.Errorf("invalid fixed code: %v", err)
+	}
+
+	return ioutil.WriteFile(filename, formatted, 0755)
+}
+
+func (c *Checker) offset(pos token.Pos) int {
+	return c.fset.Position(pos).Offset
+}
@@ -0,0 +1,82 @@
+package checker
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+func TestApplyFixes(t *testing.T) {
+	src := `package p
+
+func f() {
+	a()
+	b()
+}
+`
+	filename := filepath.Join(t.TempDir(), "p.go")
+	err := ioutil.WriteFile(filename, []byte(src), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Checker{fset: token.NewFileSet()}
+	file, err := parser.ParseFile(c.fset, filename, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokFile := c.fset.File(file.Pos())
+	pos := func(line, column int) token.Pos {
+		return tokFile.LineStart(line) + token.Pos(column-1)
+	}
+
+	diagnostic := func(analyzer string, edits ...analysis.TextEdit) Diagnostic {
+		return Diagnostic{
+			Diagnostic: analysis.Diagnostic{
+				SuggestedFixes: []analysis.SuggestedFix{{TextEdits: edits}},
+			},
+			Analyzer: analyzer,
+		}
+	}
+
+	diagnostics := []Diagnostic{
+		// Insert a return after a().
+		diagnostic("first", analysis.TextEdit{Pos: pos(4, 5), NewText: []byte("\nreturn")}),
+		// The same edit, applied once.
+		diagnostic("same", analysis.TextEdit{Pos: pos(4, 5), NewText: []byte("\nreturn")}),
+		// Replace a() with c(), overlapping with the insertion.
+		diagnostic("conflict", analysis.TextEdit{Pos: pos(4, 2), End: pos(4, 6), NewText: []byte("c()")}),
+		// Replace b() with d().
+		diagnostic("other", analysis.TextEdit{Pos: pos(5, 2), End: pos(5, 5), NewText: []byte("d()")}),
+	}
+
+	skipped, err := c.ApplyFixes(diagnostics)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(skipped) != 1 || skipped[0].Analyzer != "conflict" {
+		t.Errorf("skipped fixes %v, want the conflict fix only", skipped)
+	}
+
+	fixed, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `package p
+
+func f() {
+	a()
+	return
+	d()
+}
+`
+	if string(fixed) != expected {
+		t.Errorf("unexpected fixed source:\n%s\nexpected:\n%s", fixed, expected)
+	}
+}
@@ -0,0 +1,214 @@
+// Package debuggo provides helpers to analyze the calls to the debuggo
+// packages.
+package debuggo
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	// AssertPkg is the import path of the assert package.
+	AssertPkg = "github.com/negrel/debuggo/pkg/assert"
+	// LogPkg is the import path of the log package.
+	LogPkg = "github.com/negrel/debuggo/pkg/log"
+)
+
+// IsDebuggoPkg reports whether pkg is the assert or log package, or one of
+// their sub packages. Their own calls are not analyzed.
+func IsDebuggoPkg(pkg *types.Package) bool {
+	for _, path := range []string{AssertPkg, LogPkg} {
+		if pkg.Path() == path || strings.HasPrefix(pkg.Path(), path+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Callee returns the exported function or method of the assert or log packages
+// called by the given call, nil if it isn't a call to these packages.
+func Callee(info *types.Info, call *ast.CallExpr) *types.Func {
+	fn, isFunc := typeutil.Callee(info, call).(*types.Func)
+	if !isFunc || fn.Pkg() == nil || !fn.Exported() {
+		return nil
+	}
+
+	switch fn.Pkg().Path() {
+	case AssertPkg, LogPkg:
+		return fn
+	}
+
+	return nil
+}
+
+// IsAssertion reports whether fn is an assertion of the assert package: a
+// function or method returning a bool whose last parameter is variadic (the
+// message and its arguments).
+func IsAssertion(fn *types.Func) bool {
+	if fn.Pkg() == nil || fn.Pkg().Path() != AssertPkg {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	results := sig.Results()
+
+	return sig.Variadic() && results.Len() == 1 &&
+		types.Identical(results.At(0).Type(), types.Typ[types.Bool])
+}
+
+// LogLevel returns the level of the given log function or method, it returns
+// an empty string if fn isn't a log function. The log functions are declared
+// for every level of the log package with the same suffix: Debugf is a log
+// function of the debug level because every level has an f function, a
+// Debugger helper isn't.
+func LogLevel(fn *types.Func) string {
+	if fn.Pkg() == nil || fn.Pkg().Path() != LogPkg {
+		return ""
+	}
+
+	levels := LogLevels(fn.Pkg())
+	names := siblingNames(fn)
+	for _, level := range levels {
+		suffix, hasPrefix := strings.CutPrefix(fn.Name(), exportedName(level))
+		if !hasPrefix {
+			continue
+		}
+
+		isLevelSuffix := true
+		for _, l := range levels {
+			_, isDeclared := names[exportedName(l)+suffix]
+			isLevelSuffix = isLevelSuffix && isDeclared
+		}
+		if isLevelSuffix {
+			return level
+		}
+	}
+
+	return ""
+}
+
+// siblingNames returns the names of the functions of the package of fn, or of
+// the methods of its receiver type if it's a method.
+func siblingNames(fn *types.Func) map[string]struct{} {
+	names := make(map[string]struct{})
+
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		for _, name := range fn.Pkg().Scope().Names() {
+			names[name] = struct{}{}
+		}
+		return names
+	}
+
+	typ := recv.Type()
+	if ptr, isPtr := typ.(*types.Pointer); isPtr {
+		typ = ptr.Elem()
+	}
+	methods := types.NewMethodSet(types.NewPointer(typ))
+	for i := 0; i < methods.Len(); i++ {
+		names[methods.At(i).Obj().Name()] = struct{}{}
+	}
+
+	return names
+}
+
+// LogLevels returns the levels of the given log package: the prefixes of its
+// exported "func() bool" functions whose name ends with Enabled, with their
+// first letter in lower case.
+func LogLevels(pkg *types.Package) []string {
+	var levels []string
+	for _, name := range pkg.Scope().Names() {
+		fn, isFunc := pkg.Scope().Lookup(name).(*types.Func)
+		level, isEnabled := strings.CutSuffix(name, "Enabled")
+		if !isFunc || !isEnabled || level == "" || !fn.Exported() {
+			continue
+		}
+
+		sig := fn.Type().(*types.Signature)
+		if sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+			types.Identical(sig.Results().At(0).Type(), types.Typ[types.Bool]) {
+			levels = append(levels, strings.ToLower(level[:1])+level[1:])
+		}
+	}
+
+	return levels
+}
+
+// exportedName returns the prefix of the functions of the given level.
+func exportedName(level string) string {
+	return strings.ToUpper(level[:1]) + level[1:]
+}
+
+// IsDebugOnly reports whether fn does nothing unless it's enabled by a build
+// tag: assertions and log functions.
+func IsDebugOnly(fn *types.Func) bool {
+	return IsAssertion(fn) || LogLevel(fn) != ""
+}
+
+// Name returns the name of fn as written by the callers: pkg.Func or
+// Type.Method.
+func Name(fn *types.Func) string {
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() == nil {
+		return fn.Pkg().Name() + "." + fn.Name()
+	}
+
+	recv := sig.Recv().Type()
+	if ptr, isPtr := recv.(*types.Pointer); isPtr {
+		recv = ptr.Elem()
+	}
+	if named, isNamed := recv.(*types.Named); isNamed {
+		return named.Obj().Name() + "." + fn.Name()
+	}
+
+	return fn.Name()
+}
+
+// ZeroValue returns the source of the zero value of the given type, types are
+// qualified relatively to pkg.
+func ZeroValue(pkg *types.Package, typ types.Type) string {
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return "false"
+		case t.Info()&types.IsString != 0:
+			return `""`
+		case t.Info()&types.IsNumeric != 0:
+			return "0"
+		}
+		return "nil"
+
+	case *types.Struct, *types.Array:
+		return types.TypeString(typ, types.RelativeTo(pkg)) + "{}"
+	}
+
+	return "nil"
+}
+
+// ReturnStmt returns the source of a return statement for the given function
+// signature. Results are zero values, except the error results that are set to
+// err if it isn't empty. A bare return is used for named results.
+func ReturnStmt(pkg *types.Package, sig *types.Signature, err string) string {
+	results := sig.Results()
+	if results.Len() == 0 || results.At(0).Name() != "" {
+		return "return"
+	}
+
+	values := make([]string, results.Len())
+	for i := 0; i < results.Len(); i++ {
+		typ := results.At(i).Type()
+		if err != "" && types.Identical(typ, types.Universe.Lookup("error").Type()) {
+			values[i] = err
+			continue
+		}
+
+		values[i] = ZeroValue(pkg, typ)
+	}
+
+	return "return " + strings.Join(values, ", ")
+}
@@ -0,0 +1,391 @@
+// Package nilcheck defines an Analyzer that reports the values whose only nil
+// or error check is an assertion.
+package nilcheck
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/negrel/debuggo/internal/analysis/debuggo"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ssa"
+)
+
+const doc = `report values whose only nil or error check is an assertion
+
+Assertions do nothing when they're disabled, the values they check must be
+checked explicitly before they're used:
+
+	user, err := getUser()
+	assert.NoError(err)
+	fmt.Println(user.Name) // nil dereference if err != nil
+
+	assert.NotNil(cfg)
+	cfg.Port = 80 // nil dereference if cfg == nil
+
+The dereferences are found in the SSA form of the functions, an explicit
+comparison with nil anywhere in the function silences the report. An explicit
+check is suggested.`
+
+// Analyzer reports the values whose only nil or error check is an assertion.
+var Analyzer = &analysis.Analyzer{
+	Name:     "nilcheck",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{buildssa.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if debuggo.IsDebuggoPkg(pass.Pkg) {
+		return nil, nil
+	}
+
+	ssaInput := pass.ResultOf[buildssa.Analyzer].(*buildssa.SSA)
+
+	for _, fn := range ssaInput.SrcFuncs {
+		for _, block := range fn.Blocks {
+			for _, instr := range block.Instrs {
+				call, isCall := instr.(*ssa.Call)
+				if !isCall {
+					continue
+				}
+
+				checkAssertion(pass, call)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// checkAssertion reports the values checked by the given call, if it's an
+// assertion, and then used without an explicit check.
+func checkAssertion(pass *analysis.Pass, call *ssa.Call) {
+	callee := call.Call.StaticCallee()
+	if callee == nil {
+		return
+	}
+
+	fn, isFunc := callee.Object().(*types.Func)
+	if !isFunc || !debuggo.IsAssertion(fn) {
+		return
+	}
+
+	args := call.Call.Args
+	if call.Call.Signature().Recv() != nil || callee.Signature.Recv() != nil {
+		// Static method calls take the receiver as first argument.
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		return
+	}
+	checked := unwrap(args[0])
+
+	var used ssa.Value
+	var use ssa.Instruction
+	switch strings.TrimSuffix(fn.Name(), "f") {
+	case "NotNil":
+		if !isNillable(checked.Type()) || isError(checked.Type()) || hasNilCheck(checked) {
+			return
+		}
+		used, use = checked, dereference(call, checked)
+
+	case "NoError", "Nil":
+		if !isError(checked.Type()) || hasNilCheck(checked) {
+			return
+		}
+		used, use = usedResult(call, checked)
+
+	default:
+		return
+	}
+
+	if use == nil {
+		return
+	}
+
+	report(pass, call, fn, checked, used)
+}
+
+// report reports the assertion call. An explicit check is suggested if the
+// assertion is used as a statement.
+func report(pass *analysis.Pass, call *ssa.Call, fn *types.Func, checked, used ssa.Value) {
+	diagnostic := analysis.Diagnostic{
+		Pos: call.Pos(),
+		Message: fmt.Sprintf("%v is the only check before %v is dereferenced, it does nothing when assertions are disabled",
+			debuggo.Name(fn), name(pass, used)),
+	}
+
+	stmt := enclosingStmt(pass, call.Pos())
+	if stmt == nil {
+		pass.Report(diagnostic)
+		return
+	}
+	diagnostic.Pos, diagnostic.End = stmt.Pos(), stmt.End()
+
+	checkedName := name(pass, checked)
+	cond, errValue := checkedName+" == nil", ""
+	if isError(checked.Type()) {
+		cond, errValue = checkedName+" != nil", checkedName
+	}
+
+	returnStmt := debuggo.ReturnStmt(pass.Pkg, call.Parent().Signature, errValue)
+	diagnostic.SuggestedFixes = []analysis.SuggestedFix{{
+		Message: "Add an explicit check",
+		TextEdits: []analysis.TextEdit{{
+			Pos:     stmt.End(),
+			NewText: []byte(fmt.Sprintf("\nif %v {\n%v\n}", cond, returnStmt)),
+		}},
+	}}
+
+	pass.Report(diagnostic)
+}
+
+// unwrap returns the value converted to an interface to be passed to an
+// assertion.
+func unwrap(value ssa.Value) ssa.Value {
+	for {
+		switch v := value.(type) {
+		case *ssa.MakeInterface:
+			value = v.X
+		case *ssa.ChangeInterface:
+			value = v.X
+		default:
+			return value
+		}
+	}
+}
+
+// hasNilCheck reports whether the given value is compared with nil.
+func hasNilCheck(value ssa.Value) bool {
+	for _, ref := range *value.Referrers() {
+		binOp, isBinOp := ref.(*ssa.BinOp)
+		if !isBinOp || (binOp.Op != token.EQL && binOp.Op != token.NEQ) {
+			continue
+		}
+
+		if isNil(binOp.X) || isNil(binOp.Y) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNil(value ssa.Value) bool {
+	c, isConst := value.(*ssa.Const)
+	return isConst && c.IsNil()
+}
+
+// usedResult returns the first nillable result, returned along with the given
+// error, dereferenced after the call. The error may merge the errors of several
+// calls. The other results are valid values even if they're used without
+// checking the error.
+func usedResult(call *ssa.Call, err ssa.Value) (ssa.Value, ssa.Instruction) {
+	for _, extract := range extracts(err, make(map[ssa.Value]bool)) {
+		for _, ref := range *extract.Tuple.Referrers() {
+			result, isExtract := ref.(*ssa.Extract)
+			if !isExtract || result == extract || !isNillable(result.Type()) || hasNilCheck(result) {
+				continue
+			}
+
+			if use := dereference(call, result); use != nil {
+				return result, use
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// extracts returns the tuple results merged into value by its phis.
+func extracts(value ssa.Value, visited map[ssa.Value]bool) []*ssa.Extract {
+	if visited[value] {
+		return nil
+	}
+	visited[value] = true
+
+	switch v := value.(type) {
+	case *ssa.Extract:
+		return []*ssa.Extract{v}
+
+	case *ssa.Phi:
+		var result []*ssa.Extract
+		for _, edge := range v.Edges {
+			result = append(result, extracts(edge, visited)...)
+		}
+		return result
+	}
+
+	return nil
+}
+
+// dereference returns the first dereference of value after the call, value
+// may be dereferenced through the phis it's merged into.
+func dereference(call *ssa.Call, value ssa.Value) ssa.Instruction {
+	return dereferenceFrom(call, value, make(map[ssa.Value]bool))
+}
+
+func dereferenceFrom(call *ssa.Call, value ssa.Value, visited map[ssa.Value]bool) ssa.Instruction {
+	if visited[value] {
+		return nil
+	}
+	visited[value] = true
+
+	for _, ref := range *value.Referrers() {
+		var isDeref bool
+		switch r := ref.(type) {
+		case *ssa.FieldAddr:
+			isDeref = r.X == value
+		case *ssa.UnOp:
+			isDeref = r.Op == token.MUL && r.X == value
+		case *ssa.Call:
+			// Function values and interface methods.
+			isDeref = r.Call.Value == value
+		case *ssa.Phi:
+			if !hasNilCheck(r) {
+				if use := dereferenceFrom(call, r, visited); use != nil {
+					return use
+				}
+			}
+		}
+
+		if isDeref && isAfter(call, ref) {
+			return ref
+		}
+	}
+
+	return nil
+}
+
+// isAfter reports whether instr is always executed after the call.
+func isAfter(call *ssa.Call, instr ssa.Instruction) bool {
+	if call.Block() != instr.Block() {
+		return call.Block().Dominates(instr.Block())
+	}
+
+	for _, i := range call.Block().Instrs {
+		switch i {
+		case call:
+			return true
+		case instr:
+			return false
+		}
+	}
+
+	return false
+}
+
+// name returns the name of the variable holding value in the source.
+func name(pass *analysis.Pass, value ssa.Value) string {
+	var call ssa.Value
+	index := 0
+	switch v := value.(type) {
+	case *ssa.Phi:
+		if v.Comment != "" {
+			return v.Comment
+		}
+	case *ssa.Extract:
+		// v, err := f()
+		call, index = v.Tuple, v.Index
+	case *ssa.Call:
+		// v := f()
+		call = v
+	}
+
+	if call == nil {
+		if ident := identAt(pass, value.Pos()); ident != nil {
+			return ident.Name
+		}
+
+		return value.Name()
+	}
+
+	for _, node := range enclosingPath(pass, call.Pos()) {
+		assign, isAssign := node.(*ast.AssignStmt)
+		if !isAssign || len(assign.Lhs) <= index || !assignsCall(assign, call.Pos()) {
+			continue
+		}
+
+		if ident, isIdent := assign.Lhs[index].(*ast.Ident); isIdent {
+			return ident.Name
+		}
+	}
+
+	return value.Name()
+}
+
+// assignsCall reports whether the call whose left parenthesis is at the given
+// position is one of the assigned values.
+func assignsCall(assign *ast.AssignStmt, lparen token.Pos) bool {
+	for _, rhs := range assign.Rhs {
+		if call, isCall := ast.Unparen(rhs).(*ast.CallExpr); isCall && call.Lparen == lparen {
+			return true
+		}
+	}
+
+	return false
+}
+
+func identAt(pass *analysis.Pass, pos token.Pos) *ast.Ident {
+	path := enclosingPath(pass, pos)
+	if len(path) == 0 {
+		return nil
+	}
+
+	ident, _ := path[0].(*ast.Ident)
+	return ident
+}
+
+// enclosingStmt returns the expression statement of the call at the given
+// position, nil if the call isn't used as a statement.
+func enclosingStmt(pass *analysis.Pass, pos token.Pos) *ast.ExprStmt {
+	path := enclosingPath(pass, pos)
+	for i, node := range path {
+		if _, isCall := node.(*ast.CallExpr); !isCall || i+1 == len(path) {
+			continue
+		}
+
+		stmt, _ := path[i+1].(*ast.ExprStmt)
+		return stmt
+	}
+
+	return nil
+}
+
+func enclosingPath(pass *analysis.Pass, pos token.Pos) []ast.Node {
+	if !pos.IsValid() {
+		return nil
+	}
+
+	for _, file := range pass.Files {
+		if pass.Fset.File(file.Pos()) != pass.Fset.File(pos) {
+			continue
+		}
+
+		path, _ := astutil.PathEnclosingInterval(file, pos, pos)
+		return path
+	}
+
+	return nil
+}
+
+// isNillable reports whether values of the given type are dereferenced when
+// they're used: pointers, interfaces and functions.
+func isNillable(typ types.Type) bool {
+	switch typ.Underlying().(type) {
+	case *types.Pointer, *types.Interface, *types.Signature:
+		return true
+	}
+
+	return false
+}
+
+func isError(typ types.Type) bool {
+	return types.Identical(typ, types.Universe.Lookup("error").Type())
+}
@@ -0,0 +1,12 @@
+package nilcheck_test
+
+import (
+	"testing"
+
+	"github.com/negrel/debuggo/internal/analysis/nilcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), nilcheck.Analyzer, "a")
+}
@@ -0,0 +1,99 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/negrel/debuggo/pkg/assert"
+)
+
+type user struct {
+	Name string
+}
+
+type config struct {
+	Port int
+}
+
+func getUser() (*user, error) { return &user{}, nil }
+
+func getCachedUser() (*user, error) { return &user{}, nil }
+
+func findUser() *user { return &user{} }
+
+func getWeather() (int, error) { return 0, nil }
+
+func noError() {
+	u, err := getUser()
+	assert.NoError(err) // want `assert.NoError is the only check before u is dereferenced, it does nothing when assertions are disabled`
+	fmt.Println(u.Name)
+}
+
+func nilError() string {
+	u, err := getUser()
+	assert.Nil(err) // want `assert.Nil is the only check before u is dereferenced, it does nothing when assertions are disabled`
+	return u.Name
+}
+
+func method(a *assert.Assertions) (string, error) {
+	u, err := getUser()
+	a.NoError(err) // want `Assertions.NoError is the only check before u is dereferenced, it does nothing when assertions are disabled`
+	return u.Name, nil
+}
+
+func notNil(cfg *config) {
+	assert.NotNil(cfg) // want `assert.NotNil is the only check before cfg is dereferenced, it does nothing when assertions are disabled`
+	cfg.Port = 80
+}
+
+func explicitCheck() string {
+	u, err := getUser()
+	assert.NoError(err)
+	if err != nil {
+		return ""
+	}
+	return u.Name
+}
+
+func unused() {
+	_, err := getUser()
+	assert.NoError(err)
+}
+
+func notNillable() {
+	weather, err := getWeather()
+	assert.Nil(err)
+	fmt.Println(weather)
+}
+
+func notDereferenced() {
+	u, err := getUser()
+	assert.NoError(err)
+	fmt.Println(u)
+}
+
+func mergedError(cached bool) string {
+	var u *user
+	var err error
+	if cached {
+		u, err = getCachedUser()
+	} else {
+		u, err = getUser()
+	}
+	assert.NoError(err) // want `assert.NoError is the only check before u is dereferenced, it does nothing when assertions are disabled`
+	return u.Name
+}
+
+func singleResult() {
+	u := findUser()
+	assert.NotNil(u) // want `assert.NotNil is the only check before u is dereferenced, it does nothing when assertions are disabled`
+	fmt.Println(u.Name)
+}
+
+func mergedSingleResult(cached bool) string {
+	u := findUser()
+	if cached {
+		u = &user{}
+	}
+	assert.NotNil(u) // want `assert.NotNil is the only check before u is dereferenced, it does nothing when assertions are disabled`
+	return u.Name
+}
@@ -0,0 +1,275 @@
+// Package sideeffect defines an Analyzer that reports the arguments of debug
+// only calls that may have side effects.
+package sideeffect
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/negrel/debuggo/internal/analysis/debuggo"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const doc = `report side effects in the arguments of debug only calls
+
+Assertions and log functions are empty when they're disabled, but their
+arguments are still evaluated. Arguments containing function calls or channel
+receives behave differently across builds:
+
+	assert.NoError(db.Commit())
+	log.Debugf("%v", expensive())
+
+The lazy *fn form of the log functions (e.g. Debugfn) is suggested when it
+exists.`
+
+// Analyzer reports the side effects in the arguments of debug only calls.
+var Analyzer = &analysis.Analyzer{
+	Name:     "sideeffect",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if debuggo.IsDebuggoPkg(pass.Pkg) {
+		return nil, nil
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{(*ast.CallExpr)(nil)}
+	inspect.Preorder(nodeFilter, func(node ast.Node) {
+		call := node.(*ast.CallExpr)
+		fn := debuggo.Callee(pass.TypesInfo, call)
+		if fn == nil || !debuggo.IsDebugOnly(fn) {
+			return
+		}
+
+		for _, arg := range call.Args {
+			effect := sideEffect(pass.TypesInfo, arg)
+			if effect == nil {
+				continue
+			}
+
+			report(pass, call, fn, effect)
+			return
+		}
+	})
+
+	return nil, nil
+}
+
+func report(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func, effect ast.Expr) {
+	disabled := "assertions are disabled"
+	if level := debuggo.LogLevel(fn); level != "" {
+		disabled = fmt.Sprintf("the %v level is disabled", level)
+	}
+
+	message := fmt.Sprintf("arguments of %v are evaluated even when %v, %v may have side effects",
+		debuggo.Name(fn), disabled, render(pass.Fset, effect))
+
+	diagnostic := analysis.Diagnostic{
+		Pos:     effect.Pos(),
+		End:     effect.End(),
+		Message: message,
+	}
+
+	if lazy := lazyForm(fn); lazy != nil {
+		diagnostic.Message += fmt.Sprintf(", use %v to evaluate them lazily", debuggo.Name(lazy))
+		if fix, ok := lazyFix(pass, call, fn, lazy); ok {
+			diagnostic.SuggestedFixes = []analysis.SuggestedFix{fix}
+		}
+	}
+
+	pass.Report(diagnostic)
+}
+
+// sideEffect returns the first sub-expression of expr that may have side
+// effects: function calls (except conversions and pure builtins) and channel
+// receives. Function literals are not evaluated, they're ignored.
+func sideEffect(info *types.Info, expr ast.Expr) ast.Expr {
+	var effect ast.Expr
+
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if effect != nil {
+			return false
+		}
+
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				effect = n
+			}
+
+		case *ast.CallExpr:
+			if !isPureCall(info, n) {
+				effect = n
+			}
+		}
+
+		return effect == nil
+	})
+
+	return effect
+}
+
+// pureBuiltins are the builtin functions without side effects.
+var pureBuiltins = map[string]struct{}{
+	"cap": {}, "complex": {}, "imag": {}, "len": {}, "make": {}, "new": {}, "real": {},
+}
+
+func isPureCall(info *types.Info, call *ast.CallExpr) bool {
+	if tv, ok := info.Types[call.Fun]; ok && tv.IsType() {
+		return true
+	}
+
+	fun := call.Fun
+	for {
+		paren, isParen := fun.(*ast.ParenExpr)
+		if !isParen {
+			break
+		}
+		fun = paren.X
+	}
+
+	ident, isIdent := fun.(*ast.Ident)
+	if !isIdent {
+		return false
+	}
+
+	builtin, isBuiltin := info.Uses[ident].(*types.Builtin)
+	if !isBuiltin {
+		return false
+	}
+	_, isPure := pureBuiltins[builtin.Name()]
+
+	return isPure
+}
+
+// lazyForm returns the *fn form of the given log function, if any.
+func lazyForm(fn *types.Func) *types.Func {
+	level := debuggo.LogLevel(fn)
+	if level == "" {
+		return nil
+	}
+
+	name := fn.Name()[:len(level)] + "fn"
+	if name == fn.Name() {
+		return nil
+	}
+
+	var obj types.Object
+	if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+		obj, _, _ = types.LookupFieldOrMethod(recv.Type(), true, fn.Pkg(), name)
+	} else {
+		obj = fn.Pkg().Scope().Lookup(name)
+	}
+
+	lazy, _ := obj.(*types.Func)
+	return lazy
+}
+
+// lazyFix returns a fix replacing the call to fn with a call to its lazy form:
+//
+//	log.Debug(a, b)           -> log.Debugfn(func() []interface{} { return []interface{}{a, b} })
+//	log.Debugf("%v", a)       -> log.Debugfn(func() []interface{} { return []interface{}{fmt.Sprintf("%v", a)} })
+//
+// The Xln forms add spaces between the arguments, they're not fixed.
+func lazyFix(pass *analysis.Pass, call *ast.CallExpr, fn, lazy *types.Func) (analysis.SuggestedFix, bool) {
+	selector, isSelector := call.Fun.(*ast.SelectorExpr)
+	if !isSelector {
+		return analysis.SuggestedFix{}, false
+	}
+
+	args := renderList(pass.Fset, call.Args)
+	var values string
+	switch fn.Name()[len(debuggo.LogLevel(fn)):] {
+	case "":
+		values = "[]interface{}{" + args + "}"
+		if call.Ellipsis.IsValid() {
+			values = args
+		}
+
+	case "f":
+		fmtName, imported := importName(pass, call, "fmt")
+		if !imported {
+			return analysis.SuggestedFix{}, false
+		}
+
+		if call.Ellipsis.IsValid() {
+			args += "..."
+		}
+		values = "[]interface{}{" + fmtName + ".Sprintf(" + args + ")}"
+
+	default:
+		return analysis.SuggestedFix{}, false
+	}
+
+	newText := fmt.Sprintf("%v.%v(func() []interface{} { return %v })",
+		render(pass.Fset, selector.X), lazy.Name(), values)
+
+	return analysis.SuggestedFix{
+		Message: "Use " + debuggo.Name(lazy),
+		TextEdits: []analysis.TextEdit{{
+			Pos:     call.Pos(),
+			End:     call.End(),
+			NewText: []byte(newText),
+		}},
+	}, true
+}
+
+// importName returns the name of the given package in the file of the given
+// node, if the file imports it.
+func importName(pass *analysis.Pass, node ast.Node, path string) (string, bool) {
+	tokFile := pass.Fset.File(node.Pos())
+	for _, file := range pass.Files {
+		if pass.Fset.File(file.Pos()) != tokFile {
+			continue
+		}
+
+		for _, spec := range file.Imports {
+			if spec.Path.Value != `"`+path+`"` {
+				continue
+			}
+
+			if spec.Name == nil {
+				return path[strings.LastIndex(path, "/")+1:], true
+			}
+
+			if name := spec.Name.Name; name != "_" && name != "." {
+				return name, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func render(fset *token.FileSet, node ast.Node) string {
+	buf := &bytes.Buffer{}
+	_ = printer.Fprint(buf, fset, node)
+
+	return buf.String()
+}
+
+func renderList(fset *token.FileSet, exprs []ast.Expr) string {
+	buf := &bytes.Buffer{}
+	for i, expr := range exprs {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		_ = printer.Fprint(buf, fset, expr)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,12 @@
+package sideeffect_test
+
+import (
+	"testing"
+
+	"github.com/negrel/debuggo/internal/analysis/sideeffect"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), sideeffect.Analyzer, "a", "github.com/negrel/debuggo/pkg/log")
+}
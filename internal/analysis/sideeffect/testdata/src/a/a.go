@@ -0,0 +1,35 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/negrel/debuggo/pkg/assert"
+	"github.com/negrel/debuggo/pkg/log"
+)
+
+func commit() error { return nil }
+
+func expensive() int { return 0 }
+
+func calls(l *log.Logger, values []int, ch chan int) {
+	assert.NoError(commit()) // want `arguments of assert.NoError are evaluated even when assertions are disabled, commit\(\) may have side effects`
+
+	log.Debug("value", expensive()) // want `arguments of log.Debug are evaluated even when the debug level is disabled, expensive\(\) may have side effects, use log.Debugfn to evaluate them lazily`
+
+	log.Debugf("%v", expensive()) // want `arguments of log.Debugf are evaluated even when the debug level is disabled, expensive\(\) may have side effects, use log.Debugfn to evaluate them lazily`
+
+	log.Debugln("value", expensive()) // want `arguments of log.Debugln are evaluated even when the debug level is disabled, expensive\(\) may have side effects, use log.Debugfn to evaluate them lazily`
+
+	l.Debug(<-ch) // want `arguments of Logger.Debug are evaluated even when the debug level is disabled, <-ch may have side effects, use Logger.Debugfn to evaluate them lazily`
+
+	log.Audit("value", expensive()) // want `arguments of log.Audit are evaluated even when the audit level is disabled, expensive\(\) may have side effects, use log.Auditfn to evaluate them lazily`
+
+	fmt.Println(expensive())
+}
+
+func pure(values []int, x float64) {
+	assert.True(len(values) != 0)
+	log.Debug(int(x), cap(values), make([]int, 1))
+	log.Debugfn(func() []interface{} { return []interface{}{expensive()} })
+	log.Debugger(expensive())
+}
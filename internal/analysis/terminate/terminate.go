@@ -0,0 +1,404 @@
+// Package terminate defines an Analyzer that reports the code relying on the
+// debuggo packages to stop the execution.
+package terminate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/negrel/debuggo/internal/analysis/debuggo"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const doc = `report code relying on log.Fatal, log.Panic or assertions to terminate
+
+The Fatal and Panic log functions and the assertions are empty when they're
+disabled, the execution continues after them:
+
+	if err != nil {
+		log.Fatal("bad config")
+	}
+	useConfig(cfg)
+
+	user, err := getUser()
+	assert.NoError(err)
+	fmt.Println(user.Name)
+
+A return statement or an explicit check is suggested.`
+
+// Analyzer reports the code relying on the debuggo packages to terminate.
+var Analyzer = &analysis.Analyzer{
+	Name:     "terminate",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if debuggo.IsDebuggoPkg(pass.Pkg) {
+		return nil, nil
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{(*ast.ExprStmt)(nil)}
+	inspect.WithStack(nodeFilter, func(node ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		stmt := node.(*ast.ExprStmt)
+		call, isCall := stmt.X.(*ast.CallExpr)
+		if !isCall {
+			return true
+		}
+
+		fn := debuggo.Callee(pass.TypesInfo, call)
+		if fn == nil {
+			return true
+		}
+
+		switch level := debuggo.LogLevel(fn); {
+		case level == "fatal" || level == "panic":
+			checkFallthrough(pass, stmt, fn, stack)
+
+		case debuggo.IsAssertion(fn):
+			checkGuard(pass, stmt, call, fn, stack)
+		}
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// checkFallthrough reports the Fatal and Panic calls that aren't followed by a
+// terminating statement. The blocks and labeled statements enclosing the call
+// are walked up to the statement that follows it. The statements following an
+// if, switch or select don't count, the branch may be skipped.
+func checkFallthrough(pass *analysis.Pass, stmt *ast.ExprStmt, fn *types.Func, stack []ast.Node) {
+	followed := fallthroughCode(pass.TypesInfo, stack)
+	if followed == "" {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos: stmt.Pos(),
+		End: stmt.End(),
+		Message: fmt.Sprintf("%v doesn't stop the execution when the %v level is disabled, %v",
+			debuggo.Name(fn), debuggo.LogLevel(fn), followed),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "Add a return statement",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     stmt.End(),
+				NewText: []byte("\n" + debuggo.ReturnStmt(pass.Pkg, funcSignature(pass.TypesInfo, stack), "")),
+			}},
+		}},
+	})
+}
+
+// fallthroughCode describes the code executed after the last node of the
+// stack, an empty string if the node is followed by a terminating statement
+// or ends the body of the function.
+func fallthroughCode(info *types.Info, stack []ast.Node) string {
+	nested := false
+	for i := len(stack) - 1; i > 0; i-- {
+		node, parent := stack[i], stack[i-1]
+
+		var list []ast.Stmt
+		switch p := parent.(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return ""
+
+		case *ast.ForStmt, *ast.RangeStmt:
+			// The body or the post statement of the loop.
+			return "the loop continues"
+
+		case *ast.IfStmt:
+			return "the code following the block runs"
+
+		case *ast.LabeledStmt:
+			continue
+
+		case *ast.BlockStmt:
+			list = p.List
+		case *ast.CaseClause:
+			list = p.Body
+		case *ast.CommClause:
+			list = p.Body
+		}
+
+		for j, stmt := range list {
+			if stmt != node || j == len(list)-1 {
+				continue
+			}
+
+			if isTerminating(info, list[j+1]) {
+				return ""
+			}
+			if nested {
+				return "the code following the block runs"
+			}
+			return "the following code runs"
+		}
+
+		switch parent.(type) {
+		case *ast.CaseClause, *ast.CommClause:
+			return "the code following the block runs"
+		}
+
+		// The node ends its block, the code following the block runs.
+		nested = true
+	}
+
+	return ""
+}
+
+// isTerminating reports whether the given statement explicitly stops the
+// execution or jumps elsewhere, on every path.
+func isTerminating(info *types.Info, stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+
+	case *ast.BlockStmt:
+		return len(s.List) != 0 && isTerminating(info, s.List[len(s.List)-1])
+
+	case *ast.LabeledStmt:
+		return isTerminating(info, s.Stmt)
+
+	case *ast.IfStmt:
+		return s.Else != nil && isTerminating(info, s.Body) && isTerminating(info, s.Else)
+
+	case *ast.ExprStmt:
+		call, isCall := s.X.(*ast.CallExpr)
+		if !isCall {
+			return false
+		}
+
+		switch callee := typeutil.Callee(info, call).(type) {
+		case *types.Builtin:
+			return callee.Name() == "panic"
+		case *types.Func:
+			name := callee.FullName()
+			return name == "os.Exit" || name == "runtime.Goexit" || name == "log.Fatal" ||
+				name == "log.Fatalf" || name == "log.Fatalln" || name == "log.Panic" ||
+				name == "log.Panicf" || name == "log.Panicln"
+		}
+	}
+
+	return false
+}
+
+// checkGuard reports the assertions that guard the dereference of a pointer
+// checked by the assertion: NotNil(ptr) or NoError(err) with a pointer
+// assigned with err.
+func checkGuard(pass *analysis.Pass, stmt *ast.ExprStmt, call *ast.CallExpr, fn *types.Func, stack []ast.Node) {
+	if len(call.Args) == 0 {
+		return
+	}
+
+	checked, isIdent := call.Args[0].(*ast.Ident)
+	if !isIdent {
+		return
+	}
+	checkedObj, isVar := pass.TypesInfo.Uses[checked].(*types.Var)
+	if !isVar {
+		return
+	}
+
+	list := enclosingList(stack)
+	index := -1
+	for i, s := range list {
+		if s == stmt {
+			index = i
+		}
+	}
+	if index == -1 {
+		return
+	}
+
+	var guarded []types.Object
+	var cond, errValue string
+	switch strings.TrimSuffix(fn.Name(), "f") {
+	case "NotNil":
+		if !isNillable(checkedObj.Type()) || isError(checkedObj.Type()) {
+			return
+		}
+		guarded = []types.Object{checkedObj}
+		cond = checked.Name + " == nil"
+
+	case "NoError", "Nil":
+		if !isError(checkedObj.Type()) || index == 0 {
+			return
+		}
+		guarded = assignedWith(pass.TypesInfo, list[index-1], checkedObj)
+		cond = checked.Name + " != nil"
+		errValue = checked.Name
+
+	default:
+		return
+	}
+
+	deref := dereference(pass.TypesInfo, list[index+1:], guarded)
+	if deref == nil {
+		return
+	}
+
+	check := fmt.Sprintf("\nif %v {\n%v\n}",
+		cond, debuggo.ReturnStmt(pass.Pkg, funcSignature(pass.TypesInfo, stack), errValue))
+
+	pass.Report(analysis.Diagnostic{
+		Pos: stmt.Pos(),
+		End: stmt.End(),
+		Message: fmt.Sprintf("%v is the only check before %v is dereferenced, it does nothing when assertions are disabled",
+			debuggo.Name(fn), deref.Name()),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "Add an explicit check",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     stmt.End(),
+				NewText: []byte(check),
+			}},
+		}},
+	})
+}
+
+// enclosingList returns the statement list containing the last node of the
+// stack.
+func enclosingList(stack []ast.Node) []ast.Stmt {
+	if len(stack) < 2 {
+		return nil
+	}
+
+	switch parent := stack[len(stack)-2].(type) {
+	case *ast.BlockStmt:
+		return parent.List
+	case *ast.CaseClause:
+		return parent.Body
+	case *ast.CommClause:
+		return parent.Body
+	}
+
+	return nil
+}
+
+// assignedWith returns the nillable variables assigned by stmt along with the
+// given error.
+func assignedWith(info *types.Info, stmt ast.Stmt, err types.Object) []types.Object {
+	assign, isAssign := stmt.(*ast.AssignStmt)
+	if !isAssign {
+		return nil
+	}
+
+	objects := make([]types.Object, 0, len(assign.Lhs))
+	found := false
+	for _, lhs := range assign.Lhs {
+		ident, isIdent := lhs.(*ast.Ident)
+		if !isIdent {
+			continue
+		}
+
+		obj := info.ObjectOf(ident)
+		if obj == err {
+			found = true
+			continue
+		}
+
+		if obj != nil && isNillable(obj.Type()) {
+			objects = append(objects, obj)
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	return objects
+}
+
+// dereference returns the first guarded object dereferenced by the given
+// statements.
+func dereference(info *types.Info, stmts []ast.Stmt, guarded []types.Object) types.Object {
+	isGuarded := func(expr ast.Expr) types.Object {
+		for {
+			paren, isParen := expr.(*ast.ParenExpr)
+			if !isParen {
+				break
+			}
+			expr = paren.X
+		}
+
+		ident, isIdent := expr.(*ast.Ident)
+		if !isIdent {
+			return nil
+		}
+
+		obj := info.Uses[ident]
+		for _, g := range guarded {
+			if obj == g {
+				return obj
+			}
+		}
+
+		return nil
+	}
+
+	var deref types.Object
+	for _, stmt := range stmts {
+		ast.Inspect(stmt, func(node ast.Node) bool {
+			if deref != nil {
+				return false
+			}
+
+			switch n := node.(type) {
+			case *ast.StarExpr:
+				deref = isGuarded(n.X)
+			case *ast.SelectorExpr:
+				deref = isGuarded(n.X)
+			case *ast.CallExpr:
+				deref = isGuarded(n.Fun)
+			}
+
+			return deref == nil
+		})
+
+		if deref != nil {
+			return deref
+		}
+	}
+
+	return nil
+}
+
+func funcSignature(info *types.Info, stack []ast.Node) *types.Signature {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch fn := stack[i].(type) {
+		case *ast.FuncDecl:
+			return info.Defs[fn.Name].Type().(*types.Signature)
+		case *ast.FuncLit:
+			return info.Types[fn].Type.(*types.Signature)
+		}
+	}
+
+	return types.NewSignature(nil, nil, nil, false)
+}
+
+// isNillable reports whether values of the given type are dereferenced when
+// they're used: pointers, interfaces and functions.
+func isNillable(typ types.Type) bool {
+	switch typ.Underlying().(type) {
+	case *types.Pointer, *types.Interface, *types.Signature:
+		return true
+	}
+
+	return false
+}
+
+func isError(typ types.Type) bool {
+	return types.Identical(typ, types.Universe.Lookup("error").Type())
+}
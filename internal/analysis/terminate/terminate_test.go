@@ -0,0 +1,12 @@
+package terminate_test
+
+import (
+	"testing"
+
+	"github.com/negrel/debuggo/internal/analysis/terminate"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), terminate.Analyzer, "a")
+}
@@ -0,0 +1,102 @@
+package a
+
+import (
+	"errors"
+	"os"
+
+	"github.com/negrel/debuggo/pkg/log"
+)
+
+type config struct{}
+
+func load() (*config, error) { return nil, errors.New("") }
+
+func returnAfterBranch() *config {
+	cfg, err := load()
+	if err != nil {
+		log.Fatal(err) // want `log.Fatal doesn't stop the execution when the fatal level is disabled, the code following the block runs`
+	}
+	return cfg
+}
+
+func returnInBranch() *config {
+	cfg, err := load()
+	if err != nil {
+		log.Fatal(err)
+		return nil
+	}
+	return cfg
+}
+
+func exitInBranch() {
+	if _, err := load(); err != nil {
+		log.Fatalf("%v", err)
+		os.Exit(1)
+	}
+}
+
+func followingCode() {
+	log.Panic("unreachable") // want `log.Panic doesn't stop the execution when the panic level is disabled, the following code runs`
+	load()
+}
+
+func endOfFunction() {
+	load()
+	log.Fatal("done")
+}
+
+func loop(values []int) {
+	for range values {
+		log.Fatal("loop") // want `log.Fatal doesn't stop the execution when the fatal level is disabled, the loop continues`
+	}
+}
+
+func switchCase(v int) int {
+	switch v {
+	case 0:
+		log.Fatal("zero") // want `log.Fatal doesn't stop the execution when the fatal level is disabled, the code following the block runs`
+	case 1:
+		log.Fatal("one")
+		panic("one")
+	}
+	return v
+}
+
+func method(l *log.Logger) error {
+	if l != nil {
+		l.Fatal("method") // want `Logger.Fatal doesn't stop the execution when the fatal level is disabled, the code following the block runs`
+	}
+	return nil
+}
+
+func otherLevels() {
+	log.Error("not terminating")
+	load()
+}
+
+func nestedBlock() *config {
+	cfg, err := load()
+	if err != nil {
+		{
+			log.Fatal(err)
+		}
+		return nil
+	}
+	return cfg
+}
+
+func nestedBlockFollowed() {
+	{
+		log.Fatal("nested") // want `log.Fatal doesn't stop the execution when the fatal level is disabled, the code following the block runs`
+	}
+	load()
+}
+
+func terminatingIf(ok bool) int {
+	log.Fatal("if")
+	if ok {
+		return 1
+	} else {
+		return 0
+	}
+}
@@ -0,0 +1,31 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/negrel/debuggo/pkg/assert"
+)
+
+type user struct {
+	Name string
+}
+
+func getUser() (*user, error) { return &user{}, nil }
+
+func noError() error {
+	u, err := getUser()
+	assert.NoError(err) // want `assert.NoError is the only check before u is dereferenced, it does nothing when assertions are disabled`
+	fmt.Println(u.Name)
+	return nil
+}
+
+func notNil(u *user) {
+	assert.NotNil(u) // want `assert.NotNil is the only check before u is dereferenced, it does nothing when assertions are disabled`
+	fmt.Println(u.Name)
+}
+
+func notDereferenced() {
+	u, err := getUser()
+	assert.NoError(err)
+	fmt.Println(u)
+}
@@ -0,0 +1,18 @@
+package log
+
+type Logger struct{}
+
+func FatalEnabled() bool { return false }
+func PanicEnabled() bool { return false }
+func ErrorEnabled() bool { return false }
+
+func Fatal(v ...interface{})                 {}
+func Fatalf(format string, v ...interface{}) {}
+func Panic(v ...interface{})                 {}
+func Panicf(format string, v ...interface{}) {}
+func Error(v ...interface{})                 {}
+func Errorf(format string, v ...interface{}) {}
+
+func (l *Logger) Fatal(v ...interface{}) {}
+func (l *Logger) Panic(v ...interface{}) {}
+func (l *Logger) Error(v ...interface{}) {}
@@ -0,0 +1,417 @@
+// Package assertgen contains the generator passes of the assert packages, the
+// debuggo.yaml configuration references them by name.
+package assertgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/negrel/asttk/pkg/inspector"
+	"github.com/negrel/asttk/pkg/utils"
+	"github.com/negrel/debuggo/internal/generator"
+)
+
+// RegisterPasses registers the passes of the assert packages referenced by
+// the configuration.
+func RegisterPasses() {
+	// debug variant
+	generator.RegisterPass("fix_format_verbs", fixFormatVerbs)
+	generator.RegisterPass("report_failures", reportFailures)
+	generator.RegisterPass("forward_assertion_calls", forwardAssertionCalls)
+	generator.RegisterPass("rename_func_wrapper", generator.Inspect(renameFuncWrapper()))
+
+	// prod variant
+	generator.RegisterPass("remove_testing_t", generator.Inspect(removeTestingTInFuncDecl))
+	generator.RegisterPass("return_assertion_results", generator.ReturnValues(assertionResult))
+}
+
+// ------------
+// editor hooks
+// ------------
+
+func removeTestingTInFuncDecl(node ast.Node) bool {
+	funcDecl, isFuncDecl := node.(*ast.FuncDecl)
+	if !isFuncDecl {
+		return true
+	}
+
+	fnType := funcDecl.Type
+	i := -1
+	for i != len(fnType.Params.List)-1 {
+		i++
+		param := fnType.Params.List[i]
+
+		if !isTestingT(param) {
+			continue
+		}
+
+		fnType.Params.List = append(fnType.Params.List[:i], fnType.Params.List[i+1:]...)
+		i--
+	}
+
+	return false
+}
+
+const exportedFuncNamePrefix = "debuggoGen_"
+
+func funcFilter(name string) (replaceName string, ok bool) {
+	if !ast.IsExported(name) {
+		return "", false
+	}
+
+	replaceName = exportedFuncNamePrefix + name
+
+	return replaceName, true
+}
+
+func renameFuncWrapper() inspector.Inspector {
+	_, renameFuncCall := utils.RenameFunc(funcFilter)
+	return inspector.Lieutenant(replaceExportedFunc, renameFuncCall)
+}
+
+func replaceExportedFunc(node ast.Node) bool {
+	file, isFile := node.(*ast.File)
+	if !isFile {
+		return true
+	}
+
+	for i, decl := range file.Decls {
+		funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+		// Methods forward the calls to the renamed functions themselves.
+		if !isFuncDecl || funcDecl.Recv != nil {
+			continue
+		}
+
+		newName, ok := funcFilter(funcDecl.Name.Name)
+		if !ok || newName == "" {
+			continue
+		}
+
+		// The wrapper reports failures to the std Assertions object.
+		params := &ast.FieldList{
+			Opening: funcDecl.Type.Params.Opening,
+			Closing: funcDecl.Type.Params.Closing,
+		}
+		args := make([]ast.Expr, 0, len(funcDecl.Type.Params.List))
+		for _, field := range funcDecl.Type.Params.List {
+			if isTestingT(field) {
+				args = append(args, newAssertionCall(ast.NewIdent("std"), funcDecl))
+				continue
+			}
+
+			params.List = append(params.List, field)
+			args = append(args, extractArguments(field)...)
+		}
+
+		call := &ast.CallExpr{
+			Fun:      funcDecl.Name,
+			Args:     args,
+			Ellipsis: variadicPos(funcDecl.Type),
+		}
+
+		// Forward the results of the renamed function.
+		var stmt ast.Stmt = &ast.ExprStmt{X: call}
+		if funcDecl.Type.Results != nil && len(funcDecl.Type.Results.List) != 0 {
+			stmt = &ast.ReturnStmt{Results: []ast.Expr{call}}
+		}
+
+		file.Decls[i] = &ast.FuncDecl{
+			Name: funcDecl.Name,
+			Doc:  funcDecl.Doc,
+			Type: &ast.FuncType{
+				Func:    funcDecl.Type.Func,
+				Params:  params,
+				Results: funcDecl.Type.Results,
+			},
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{stmt},
+			},
+		}
+
+		// The doc comment belongs to the exported wrapper.
+		funcDecl.Doc = nil
+		funcDecl.Name = ast.NewIdent(newName)
+		file.Decls = append(file.Decls, funcDecl)
+	}
+	return false
+}
+
+// fixFormatVerbs is a Pass that replaces the %s verbs of the fmt.Sprintf calls
+// formatting an interface{} parameter with %v. Otherwise, non string values are
+// rendered as %!s(int=1).
+func fixFormatVerbs(file *generator.File) {
+	for _, decl := range file.AST().Decls {
+		funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+		if !isFuncDecl || funcDecl.Body == nil {
+			continue
+		}
+
+		params := interfaceParams(funcDecl.Type)
+		if len(params) == 0 {
+			continue
+		}
+
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			callExpr, isCallExpr := node.(*ast.CallExpr)
+			if !isCallExpr || fmt.Sprint(callExpr.Fun) != "&{fmt Sprintf}" || len(callExpr.Args) == 0 {
+				return true
+			}
+
+			lit, isLit := callExpr.Args[0].(*ast.BasicLit)
+			if !isLit || lit.Kind != token.STRING {
+				return true
+			}
+
+			format, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return true
+			}
+
+			fixed, ok := replaceVerbs(format, func(i int, verb byte) byte {
+				if verb != 's' || i+1 >= len(callExpr.Args) {
+					return verb
+				}
+
+				ident, isIdent := callExpr.Args[i+1].(*ast.Ident)
+				if !isIdent {
+					return verb
+				}
+
+				if _, isParam := params[ident.Name]; isParam {
+					return 'v'
+				}
+
+				return verb
+			})
+			if ok && fixed != format {
+				lit.Value = strconv.Quote(fixed)
+			}
+
+			return true
+		})
+	}
+}
+
+// interfaceParams returns the names of the interface{} parameters of the
+// given function type.
+func interfaceParams(funcType *ast.FuncType) map[string]struct{} {
+	params := make(map[string]struct{})
+	for _, field := range funcType.Params.List {
+		iface, isInterface := field.Type.(*ast.InterfaceType)
+		if !isInterface || len(iface.Methods.List) != 0 {
+			continue
+		}
+
+		for _, name := range field.Names {
+			params[name.Name] = struct{}{}
+		}
+	}
+
+	return params
+}
+
+// replaceVerbs calls replace with the index of the argument and the verb of
+// every verb of the given format string and replaces the verb with the
+// returned one. Formats using explicit argument indexes or * are not
+// supported, ok is false for them.
+func replaceVerbs(format string, replace func(i int, verb byte) byte) (result string, ok bool) {
+	buf := []byte(format)
+	arg := 0
+
+	for i := 0; i < len(buf); i++ {
+		if buf[i] != '%' {
+			continue
+		}
+
+		// Skip the flags, width and precision.
+		i++
+		for i < len(buf) && strings.IndexByte("+-# 0123456789.", buf[i]) != -1 {
+			i++
+		}
+		if i == len(buf) {
+			break
+		}
+
+		switch buf[i] {
+		case '%':
+			continue
+		case '[', '*':
+			return format, false
+		}
+
+		buf[i] = replace(arg, buf[i])
+		arg++
+	}
+
+	return string(buf), true
+}
+
+// reportFailures is a Pass that replaces the t.Errorf call of Fail with a call
+// to reportFailure, so failures are reported with their structured content.
+// The statements building the labeled content of t.Errorf, including the walk
+// of the call stack, are removed as reportFailure builds its own.
+func reportFailures(file *generator.File) {
+	for _, decl := range file.AST().Decls {
+		funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+		if !isFuncDecl || funcDecl.Recv != nil || funcDecl.Name.Name != "Fail" {
+			continue
+		}
+
+		stmts := funcDecl.Body.List[:0]
+		for _, stmt := range funcDecl.Body.List {
+			if buildsLabeledContent(stmt) {
+				continue
+			}
+
+			if exprStmt, isExprStmt := stmt.(*ast.ExprStmt); isExprStmt && isTErrorf(exprStmt.X) {
+				stmt = &ast.ExprStmt{
+					X: &ast.CallExpr{
+						Fun: ast.NewIdent("reportFailure"),
+						Args: []ast.Expr{
+							ast.NewIdent("t"),
+							ast.NewIdent("failureMessage"),
+							ast.NewIdent("message"),
+						},
+					},
+				}
+			}
+			stmts = append(stmts, stmt)
+		}
+		funcDecl.Body.List = stmts
+	}
+}
+
+// buildsLabeledContent reports whether the given statement only assigns the
+// content variable of Fail, or is an if statement that only does that.
+func buildsLabeledContent(stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range s.Lhs {
+			if ident, isIdent := lhs.(*ast.Ident); !isIdent || ident.Name != "content" {
+				return false
+			}
+		}
+		return true
+
+	case *ast.IfStmt:
+		if s.Else != nil || len(s.Body.List) == 0 {
+			return false
+		}
+		for _, bodyStmt := range s.Body.List {
+			if !buildsLabeledContent(bodyStmt) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+func isTErrorf(expr ast.Expr) bool {
+	callExpr, isCallExpr := expr.(*ast.CallExpr)
+	if !isCallExpr {
+		return false
+	}
+
+	selector, isSelector := callExpr.Fun.(*ast.SelectorExpr)
+	if !isSelector {
+		return false
+	}
+	ident, isIdent := selector.X.(*ast.Ident)
+
+	return isIdent && ident.Name == "t" && selector.Sel.Name == "Errorf"
+}
+
+// forwardAssertionCalls is a Pass that makes the methods of Assertions report
+// their failures to their receiver: the a.t arguments are replaced with
+// a.newCall(...) and the tHelper checks are removed.
+func forwardAssertionCalls(file *generator.File) {
+	for _, decl := range file.AST().Decls {
+		funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+		if !isFuncDecl || funcDecl.Recv == nil || len(funcDecl.Recv.List[0].Names) == 0 {
+			continue
+		}
+		recv := funcDecl.Recv.List[0].Names[0].Name
+
+		stmts := funcDecl.Body.List[:0]
+		for _, stmt := range funcDecl.Body.List {
+			// if h, ok := a.t.(tHelper); ok { h.Helper() }
+			if ifStmt, isIfStmt := stmt.(*ast.IfStmt); isIfStmt && isTHelperAssert(ifStmt.Init) {
+				// Move the brace so that the printer doesn't leave a blank line.
+				funcDecl.Body.Lbrace = ifStmt.End() - 1
+				continue
+			}
+			stmts = append(stmts, stmt)
+		}
+		funcDecl.Body.List = stmts
+
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			callExpr, isCallExpr := node.(*ast.CallExpr)
+			if !isCallExpr {
+				return true
+			}
+
+			for i, arg := range callExpr.Args {
+				if isRecvT(arg, recv) {
+					callExpr.Args[i] = newAssertionCall(ast.NewIdent(recv), funcDecl)
+				}
+			}
+
+			return true
+		})
+	}
+}
+
+func isTHelperAssert(stmt ast.Stmt) bool {
+	assignStmt, isAssignStmt := stmt.(*ast.AssignStmt)
+	if !isAssignStmt || len(assignStmt.Rhs) != 1 {
+		return false
+	}
+
+	typeAssert, isTypeAssert := assignStmt.Rhs[0].(*ast.TypeAssertExpr)
+	if !isTypeAssert {
+		return false
+	}
+	ident, isIdent := typeAssert.Type.(*ast.Ident)
+
+	return isIdent && ident.Name == "tHelper"
+}
+
+func isRecvT(expr ast.Expr, recv string) bool {
+	selector, isSelector := expr.(*ast.SelectorExpr)
+	if !isSelector {
+		return false
+	}
+	ident, isIdent := selector.X.(*ast.Ident)
+
+	return isIdent && ident.Name == recv && selector.Sel.Name == "t"
+}
+
+// assertionResult returns the value returned by the prod version of the
+// assertions: they always succeed.
+func assertionResult(funcDecl *ast.FuncDecl, result ast.Expr) ast.Expr {
+	if !isAssertion(funcDecl) || fmt.Sprint(result) != "bool" {
+		return nil
+	}
+
+	return ast.NewIdent("true")
+}
+
+// isAssertion reports whether the given function is an assertion, that is, a
+// function whose last parameter is msgAndArgs.
+func isAssertion(funcDecl *ast.FuncDecl) bool {
+	params := funcDecl.Type.Params.List
+	if len(params) == 0 {
+		return false
+	}
+
+	last := params[len(params)-1]
+	_, isVariadic := last.Type.(*ast.Ellipsis)
+
+	return isVariadic && len(last.Names) == 1 &&
+		(last.Names[0].Name == "msgAndArgs" || last.Names[0].Name == "_")
+}
@@ -0,0 +1,71 @@
+package assertgen
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+)
+
+func extractArguments(field *ast.Field) []ast.Expr {
+	result := make([]ast.Expr, 0, len(field.Names))
+
+	for _, name := range field.Names {
+		result = append(result, &ast.Ident{
+			Name: name.Name,
+		})
+	}
+
+	return result
+}
+
+// variadicPos returns the position of the ellipsis of the variadic parameter
+// of the given function, or token.NoPos if it isn't variadic. Calls forwarding
+// the parameters must use it so the variadic arguments are passed with "...".
+func variadicPos(funcType *ast.FuncType) token.Pos {
+	params := funcType.Params.List
+	if len(params) == 0 {
+		return token.NoPos
+	}
+
+	ellipsis, isEllipsis := params[len(params)-1].Type.(*ast.Ellipsis)
+	if !isEllipsis {
+		return token.NoPos
+	}
+
+	return ellipsis.Ellipsis
+}
+
+func isTestingT(field *ast.Field) bool {
+	ident, isIdent := field.Type.(*ast.Ident)
+
+	return isIdent && ident.Name == "TestingT"
+}
+
+// newAssertionCall returns the expression of the TestingT of a call to the
+// given assertion: recv.newCall("Name", expected, actual). The expected and
+// actual values are those of the parameters of the same name, if any.
+func newAssertionCall(recv ast.Expr, funcDecl *ast.FuncDecl) ast.Expr {
+	expected, actual := ast.NewIdent("nil"), ast.NewIdent("nil")
+	for _, field := range funcDecl.Type.Params.List {
+		for _, name := range field.Names {
+			switch name.Name {
+			case "expected":
+				expected = ast.NewIdent(name.Name)
+			case "actual":
+				actual = ast.NewIdent(name.Name)
+			}
+		}
+	}
+
+	return &ast.CallExpr{
+		Fun: &ast.SelectorExpr{
+			X:   recv,
+			Sel: ast.NewIdent("newCall"),
+		},
+		Args: []ast.Expr{
+			&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(funcDecl.Name.Name)},
+			expected,
+			actual,
+		},
+	}
+}
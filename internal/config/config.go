@@ -0,0 +1,154 @@
+// Package config loads the debuggo.yaml project configuration.
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the project configuration file.
+const FileName = "debuggo.yaml"
+
+// Config is the project configuration.
+type Config struct {
+	// Profiles maps the name of a profile to the build tags it sets. A profile
+	// is enabled by its own build tag, see ProfileTag.
+	Profiles map[string][]string `yaml:"profiles"`
+	// Packages are the conditional packages generated by "debuggo generate".
+	Packages []Package `yaml:"packages"`
+
+	// dir is the directory of the configuration file, relative paths of the
+	// configuration are relative to it.
+	dir string
+}
+
+// Package is the configuration of a generated package.
+type Package struct {
+	// Name identifies the package, it's also used by the build tags of the
+	// levels (e.g. debuggo_log_info for the info level of the log package).
+	Name string `yaml:"name"`
+	// Src is the directory of the source package.
+	Src string `yaml:"src"`
+	// Common is a directory whose files are copied as is.
+	Common string `yaml:"common"`
+	// Output is the directory of the generated package.
+	Output string `yaml:"output"`
+	// Include restricts the generated source files to the given file names.
+	Include []string `yaml:"include"`
+	// Exclude skips the source files with the given names.
+	Exclude []string `yaml:"exclude"`
+	// Levels adds an ordered variant per level.
+	Levels *Levels `yaml:"levels"`
+	// Variants are the variants generated for every source file. They default
+	// to a debug variant enabled by the debuggo_<name> tag and the prod
+	// variant if the package has no levels.
+	Variants []Variant `yaml:"variants"`
+}
+
+// Levels is the configuration of ordered variants, one per level. A level is
+// enabled by its tag (e.g. debuggo_log_info), its alias (info) or a profile,
+// the most verbose of the enabled levels is selected.
+type Levels struct {
+	// Names are the levels, from the least to the most verbose.
+	Names []string `yaml:"names"`
+	// Passes are the names of the passes of the level variants, applied in
+	// order. The "remove_disabled_levels" pass empties the functions of the
+	// more verbose levels.
+	Passes []string `yaml:"passes"`
+}
+
+// Variant is the configuration of a variant of a generated package.
+type Variant struct {
+	// Suffix is inserted before the extension of the generated file names.
+	Suffix string `yaml:"suffix"`
+	// Tags are a build tag and its aliases, the variant is enabled if any of
+	// them is set. The variant without tags is selected when no other variant
+	// is.
+	Tags []string `yaml:"tags"`
+	// Passes are the names of the passes of the variant, applied in order.
+	Passes []string `yaml:"passes"`
+}
+
+// Load reads and validates the configuration file at the given path.
+func Load(path string) (*Config, error) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{dir: filepath.Dir(path)}
+	err = yaml.Unmarshal(src, config)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+
+	err = config.validate()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	for name, tags := range c.Profiles {
+		if name == "" {
+			return fmt.Errorf("a profile name is empty")
+		}
+
+		if len(tags) == 0 {
+			return fmt.Errorf("the profile %q sets no tag", name)
+		}
+	}
+
+	names := make(map[string]struct{}, len(c.Packages))
+	for _, pkg := range c.Packages {
+		if pkg.Name == "" {
+			return fmt.Errorf("a package name is empty")
+		}
+
+		if _, isDuplicate := names[pkg.Name]; isDuplicate {
+			return fmt.Errorf("the package %q is defined twice", pkg.Name)
+		}
+		names[pkg.Name] = struct{}{}
+
+		if pkg.Src == "" {
+			return fmt.Errorf("the source directory of the package %q is empty", pkg.Name)
+		}
+
+		if pkg.Output == "" {
+			return fmt.Errorf("the output directory of the package %q is empty", pkg.Name)
+		}
+
+		if pkg.Levels != nil && len(pkg.Levels.Names) == 0 {
+			return fmt.Errorf("the package %q has no level", pkg.Name)
+		}
+	}
+
+	return nil
+}
+
+// path returns the given path of the configuration relative to the current
+// directory.
+func (c *Config) path(path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(c.dir, path)
+}
+
+// profileNames returns the sorted names of the profiles.
+func (c *Config) profileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"fmt"
+	"go/build/constraint"
+	"strings"
+
+	"github.com/negrel/debuggo/internal/generator"
+)
+
+const (
+	// ExpandLevels is the name of the pass that declares the templates of the
+	// source files for every level of the package, see
+	// generator.ExpandTemplates.
+	ExpandLevels = "expand_levels"
+	// RemoveDisabledLevels is the name of the pass of the level variants that
+	// empties the functions of the more verbose levels.
+	RemoveDisabledLevels = "remove_disabled_levels"
+)
+
+// Generators returns the generators of the packages with the given names, or
+// of every package if no name is given.
+func (c *Config) Generators(names ...string) ([]*generator.Generator, error) {
+	packages := c.Packages
+	if len(names) != 0 {
+		packages = make([]Package, 0, len(names))
+		for _, name := range names {
+			pkg, err := c.Package(name)
+			if err != nil {
+				return nil, err
+			}
+
+			packages = append(packages, pkg)
+		}
+	}
+
+	generators := make([]*generator.Generator, 0, len(packages))
+	for _, pkg := range packages {
+		gen, err := c.generator(pkg)
+		if err != nil {
+			return nil, fmt.Errorf("package %q: %v", pkg.Name, err)
+		}
+
+		generators = append(generators, gen)
+	}
+
+	return generators, nil
+}
+
+// Package returns the package with the given name.
+func (c *Config) Package(name string) (Package, error) {
+	for _, pkg := range c.Packages {
+		if pkg.Name == name {
+			return pkg, nil
+		}
+	}
+
+	return Package{}, fmt.Errorf("the package %q is not defined", name)
+}
+
+func (c *Config) generator(pkg Package) (*generator.Generator, error) {
+	variants, err := c.variants(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []generator.Option{
+		generator.SrcDir(c.path(pkg.Src)),
+		generator.OutputDir(c.path(pkg.Output)),
+		generator.Include(pkg.Include...),
+		generator.Exclude(pkg.Exclude...),
+		generator.Variants(variants...),
+	}
+	if pkg.Common != "" {
+		options = append(options, generator.CommonDir(c.path(pkg.Common)))
+	}
+
+	return generator.New(options...)
+}
+
+func (c *Config) variants(pkg Package) ([]*generator.Variant, error) {
+	if pkg.Levels == nil && len(pkg.Variants) == 0 {
+		debug := generator.Debug()
+		debug.Constraint = c.Feature(PackageTag(pkg.Name))
+
+		return []*generator.Variant{debug, generator.Prod()}, nil
+	}
+
+	variants := make([]*generator.Variant, 0, len(pkg.Variants))
+	if pkg.Levels != nil {
+		levels, err := c.levelVariants(pkg.Name, pkg.Levels)
+		if err != nil {
+			return nil, err
+		}
+
+		variants = append(variants, levels...)
+	}
+
+	var levels []string
+	if pkg.Levels != nil {
+		levels = pkg.Levels.Names
+	}
+
+	for _, v := range pkg.Variants {
+		passes, err := lookupPasses(v.Passes, levels, -1)
+		if err != nil {
+			return nil, err
+		}
+
+		variant := &generator.Variant{Suffix: v.Suffix, Passes: passes}
+		if len(v.Tags) != 0 {
+			variant.Constraint = c.Feature(v.Tags[0], v.Tags[1:]...)
+		}
+
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
+
+// levelVariants returns the variants of the levels of the given package.
+func (c *Config) levelVariants(pkg string, levels *Levels) ([]*generator.Variant, error) {
+	features := make([]constraint.Expr, len(levels.Names))
+	for i, level := range levels.Names {
+		features[i] = c.Feature(LevelTag(pkg, level), level)
+	}
+	constraints := generator.Ordered(features...)
+
+	variants := make([]*generator.Variant, len(levels.Names))
+	for i, level := range levels.Names {
+		passes, err := lookupPasses(levels.Passes, levels.Names, i)
+		if err != nil {
+			return nil, err
+		}
+
+		variants[i] = &generator.Variant{
+			Suffix:     "." + level,
+			Constraint: constraints[i],
+			Passes:     passes,
+		}
+	}
+
+	return variants, nil
+}
+
+// lookupPasses returns the registered passes with the given names. The level
+// passes are built using the levels of the package and the index of the level
+// of the variant, -1 if the variant isn't a level variant.
+func lookupPasses(names []string, levels []string, level int) ([]generator.Pass, error) {
+	passes := make([]generator.Pass, len(names))
+	for i, name := range names {
+		switch {
+		case name == ExpandLevels && len(levels) != 0:
+			passes[i] = generator.ExpandTemplates(levels)
+
+		case name == RemoveDisabledLevels && level != -1:
+			passes[i] = generator.RemoveFuncBodies(levelFilter(levels, level))
+
+		default:
+			pass, err := generator.LookupPass(name)
+			if err != nil {
+				return nil, err
+			}
+
+			passes[i] = pass
+		}
+	}
+
+	return passes, nil
+}
+
+// levelFilter reports whether a function belongs to one of the given levels up
+// to the enabled one, the levels are cut off by position. name is either the
+// level set by the //debuggo:level directive of the function or its name,
+// whose level is the longest level name it starts with. Functions that don't
+// belong to any level are kept.
+func levelFilter(levels []string, enabled int) func(name string) bool {
+	return func(name string) bool {
+		name = strings.ToLower(name)
+
+		level, length := -1, 0
+		for i, l := range levels {
+			l = strings.ToLower(l)
+			if strings.HasPrefix(name, l) && len(l) > length {
+				level, length = i, len(l)
+			}
+		}
+
+		return level <= enabled
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"go/build/constraint"
+
+	"github.com/negrel/debuggo/internal/generator"
+)
+
+// TagPrefix namespaces the build tags of the debuggo packages.
+const TagPrefix = "debuggo_"
+
+// AssertTag is the build tag that enables the assertions, "assert" is an
+// alias.
+const AssertTag = TagPrefix + "assert"
+
+// PackageTag returns the build tag that enables the debug variant of the
+// given package (e.g. "debuggo_assert").
+func PackageTag(pkg string) string {
+	return TagPrefix + pkg
+}
+
+// LevelTag returns the build tag that enables a level of the given package,
+// the level name is an alias (e.g. "debuggo_log_debug" and "debug").
+func LevelTag(pkg, level string) string {
+	return PackageTag(pkg) + "_" + level
+}
+
+// ProfileTag returns the build tag that enables the given profile (e.g.
+// "debuggo_dev" for the "dev" profile).
+func ProfileTag(name string) string {
+	return TagPrefix + name
+}
+
+// Feature returns the constraint satisfied when the given build tag, one of
+// its aliases or a profile setting any of them is set.
+func (c *Config) Feature(tag string, aliases ...string) constraint.Expr {
+	names := append([]string{tag}, aliases...)
+
+	exprs := make([]constraint.Expr, 0, len(names))
+	for _, name := range names {
+		exprs = append(exprs, generator.Tag(name))
+	}
+
+	for _, profile := range c.profileNames() {
+		if setsAny(c.Profiles[profile], names) {
+			exprs = append(exprs, generator.Tag(ProfileTag(profile)))
+		}
+	}
+
+	return generator.Or(exprs...)
+}
+
+func setsAny(tags, names []string) bool {
+	for _, tag := range tags {
+		for _, name := range names {
+			if tag == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,196 @@
+package generator
+
+import (
+	"go/build/constraint"
+	"math"
+)
+
+// bdd is a reduced ordered binary decision diagram of build constraints. Two
+// equivalent constraints have the same node, the satisfiability and the
+// equivalence of constraints are decided without evaluating every combination
+// of their tags.
+//
+// The nodes are indexes, 0 and 1 are the false and true terminals.
+type bdd struct {
+	tags   []string
+	order  map[string]int
+	nodes  []bddNode
+	unique map[bddNode]int
+	cache  map[bddOp]int
+}
+
+// bddNode tests the tag of the given order: the low node follows if it's not
+// set, the high node otherwise.
+type bddNode struct {
+	tag       int
+	low, high int
+}
+
+type bddOp struct {
+	op   byte
+	x, y int
+}
+
+const (
+	bddFalse = 0
+	bddTrue  = 1
+)
+
+// bddTerminal is the tag order of the terminals, after every tag.
+const bddTerminal = math.MaxInt
+
+// newBDD returns a bdd whose tags are ordered by appearance in the given
+// constraints.
+func newBDD(exprs ...constraint.Expr) *bdd {
+	b := &bdd{
+		tags:   exprTags(exprs...),
+		order:  make(map[string]int),
+		nodes:  []bddNode{{tag: bddTerminal}, {tag: bddTerminal}},
+		unique: make(map[bddNode]int),
+		cache:  make(map[bddOp]int),
+	}
+	for i, tag := range b.tags {
+		b.order[tag] = i
+	}
+
+	return b
+}
+
+// node returns the node of the given constraint, a nil constraint is always
+// satisfied.
+func (b *bdd) node(expr constraint.Expr) int {
+	switch e := expr.(type) {
+	case nil:
+		return bddTrue
+	case *constraint.TagExpr:
+		order, isKnown := b.order[e.Tag]
+		if !isKnown {
+			order = len(b.tags)
+			b.tags = append(b.tags, e.Tag)
+			b.order[e.Tag] = order
+		}
+		return b.mk(order, bddFalse, bddTrue)
+	case *constraint.NotExpr:
+		return b.not(b.node(e.X))
+	case *constraint.AndExpr:
+		return b.and(b.node(e.X), b.node(e.Y))
+	case *constraint.OrExpr:
+		return b.or(b.node(e.X), b.node(e.Y))
+	}
+
+	panic("unknown constraint type")
+}
+
+func (b *bdd) mk(tag, low, high int) int {
+	if low == high {
+		return low
+	}
+
+	n := bddNode{tag: tag, low: low, high: high}
+	if i, isKnown := b.unique[n]; isKnown {
+		return i
+	}
+
+	b.nodes = append(b.nodes, n)
+	b.unique[n] = len(b.nodes) - 1
+
+	return len(b.nodes) - 1
+}
+
+func (b *bdd) not(x int) int {
+	switch x {
+	case bddFalse:
+		return bddTrue
+	case bddTrue:
+		return bddFalse
+	}
+
+	key := bddOp{op: '!', x: x}
+	if result, isCached := b.cache[key]; isCached {
+		return result
+	}
+
+	n := b.nodes[x]
+	result := b.mk(n.tag, b.not(n.low), b.not(n.high))
+	b.cache[key] = result
+
+	return result
+}
+
+func (b *bdd) and(x, y int) int {
+	switch {
+	case x == bddFalse || y == bddFalse:
+		return bddFalse
+	case x == bddTrue || x == y:
+		return y
+	case y == bddTrue:
+		return x
+	}
+
+	return b.apply('&', x, y, b.and)
+}
+
+func (b *bdd) or(x, y int) int {
+	switch {
+	case x == bddTrue || y == bddTrue:
+		return bddTrue
+	case x == bddFalse || x == y:
+		return y
+	case y == bddFalse:
+		return x
+	}
+
+	return b.apply('|', x, y, b.or)
+}
+
+// apply applies the given binary operator on the cofactors of the nodes.
+func (b *bdd) apply(op byte, x, y int, fn func(x, y int) int) int {
+	if x > y {
+		x, y = y, x
+	}
+
+	key := bddOp{op: op, x: x, y: y}
+	if result, isCached := b.cache[key]; isCached {
+		return result
+	}
+
+	nx, ny := b.nodes[x], b.nodes[y]
+	tag := nx.tag
+	if ny.tag < tag {
+		tag = ny.tag
+	}
+
+	xLow, xHigh := x, x
+	if nx.tag == tag {
+		xLow, xHigh = nx.low, nx.high
+	}
+	yLow, yHigh := y, y
+	if ny.tag == tag {
+		yLow, yHigh = ny.low, ny.high
+	}
+
+	result := b.mk(tag, fn(xLow, yLow), fn(xHigh, yHigh))
+	b.cache[key] = result
+
+	return result
+}
+
+// witness returns a set of tags satisfying the given node, with as few tags
+// as possible set. The node must not be false.
+func (b *bdd) witness(x int) func(tag string) bool {
+	set := make(map[string]bool)
+	for x != bddTrue {
+		n := b.nodes[x]
+		if n.low != bddFalse {
+			x = n.low
+			continue
+		}
+
+		set[b.tags[n.tag]] = true
+		x = n.high
+	}
+
+	return func(tag string) bool {
+		return set[tag]
+	}
+}
@@ -0,0 +1,229 @@
+package generator
+
+import (
+	"fmt"
+	"go/build/constraint"
+	"strings"
+)
+
+// Tag returns a constraint satisfied when the given build tag is set.
+func Tag(tag string) constraint.Expr {
+	return &constraint.TagExpr{Tag: tag}
+}
+
+// Not returns the negation of the given constraint. Negations are pushed down
+// to the tags to keep the constraint readable.
+func Not(expr constraint.Expr) constraint.Expr {
+	switch e := expr.(type) {
+	case *constraint.NotExpr:
+		return e.X
+	case *constraint.AndExpr:
+		return Or(Not(e.X), Not(e.Y))
+	case *constraint.OrExpr:
+		return And(Not(e.X), Not(e.Y))
+	}
+
+	return &constraint.NotExpr{X: expr}
+}
+
+// And returns a constraint satisfied when all the given constraints are. Nil
+// constraints are ignored.
+func And(exprs ...constraint.Expr) constraint.Expr {
+	return join(exprs, func(x, y constraint.Expr) constraint.Expr {
+		return &constraint.AndExpr{X: x, Y: y}
+	})
+}
+
+// Or returns a constraint satisfied when any of the given constraints is. Nil
+// constraints are ignored.
+func Or(exprs ...constraint.Expr) constraint.Expr {
+	return join(exprs, func(x, y constraint.Expr) constraint.Expr {
+		return &constraint.OrExpr{X: x, Y: y}
+	})
+}
+
+// Ordered returns a constraint per given constraint, ordered from the least to
+// the most significant. A constraint is satisfied when its given constraint is
+// and none of the following ones is, thus overlapping constraints resolve to
+// the last one:
+//
+//	Ordered(Tag("info"), Tag("debug"), Tag("trace")) // info && !debug && !trace, debug && !trace, trace
+func Ordered(exprs ...constraint.Expr) []constraint.Expr {
+	result := make([]constraint.Expr, len(exprs))
+	for i, expr := range exprs {
+		result[i] = expr
+		for _, next := range exprs[i+1:] {
+			result[i] = And(result[i], Not(next))
+		}
+	}
+
+	return result
+}
+
+func join(exprs []constraint.Expr, op func(x, y constraint.Expr) constraint.Expr) constraint.Expr {
+	var result constraint.Expr
+	for _, expr := range exprs {
+		switch {
+		case expr == nil:
+			continue
+		case result == nil:
+			result = expr
+		default:
+			result = op(result, expr)
+		}
+	}
+
+	return result
+}
+
+// constraintLines returns the //go:build line of the given constraint followed
+// by the equivalent // +build lines.
+func constraintLines(expr constraint.Expr) ([]string, error) {
+	plusBuild, err := constraint.PlusBuildLines(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string{"//go:build " + expr.String()}, plusBuild...), nil
+}
+
+// complete returns the given variants with the constraint of the default
+// variant, the one without a constraint, derived as the complement of the
+// other variants.
+func complete(variants []*Variant) ([]*Variant, error) {
+	defaultIndex := -1
+	constraints := make([]constraint.Expr, 0, len(variants))
+	for i, variant := range variants {
+		if variant.Constraint != nil {
+			constraints = append(constraints, variant.Constraint)
+			continue
+		}
+
+		if defaultIndex != -1 {
+			return nil, fmt.Errorf("variants %q and %q both have no constraint",
+				variants[defaultIndex].Suffix, variant.Suffix)
+		}
+		defaultIndex = i
+	}
+
+	if defaultIndex == -1 || len(constraints) == 0 {
+		return variants, nil
+	}
+
+	// Variants may be shared by generators.
+	defaultVariant := *variants[defaultIndex]
+	defaultVariant.Constraint = simplify(Not(Or(constraints...)))
+
+	result := append([]*Variant(nil), variants...)
+	result[defaultIndex] = &defaultVariant
+
+	return result, nil
+}
+
+// simplify returns the conjunction of tags and negated tags equivalent to the
+// given constraint, if any, the constraint otherwise.
+func simplify(expr constraint.Expr) constraint.Expr {
+	b := newBDD(expr)
+
+	// The diagram of a conjunction is a single path to the true terminal.
+	var result constraint.Expr
+	for x := b.node(expr); x != bddTrue; {
+		n := b.nodes[x]
+		switch {
+		case x == bddFalse:
+			return expr
+		case n.low == bddFalse:
+			result = And(result, Tag(b.tags[n.tag]))
+			x = n.high
+		case n.high == bddFalse:
+			result = And(result, Not(Tag(b.tags[n.tag])))
+			x = n.low
+		default:
+			return expr
+		}
+	}
+
+	if result == nil {
+		return expr
+	}
+
+	return result
+}
+
+// checkExclusive proves that exactly one of the given variants is selected for
+// any set of build tags: the constraints are pairwise disjoint and their
+// disjunction is always satisfied. The proof doesn't evaluate every
+// combination of the tags, see bdd.
+func checkExclusive(variants []*Variant) error {
+	exprs := make([]constraint.Expr, 0, len(variants))
+	for _, variant := range variants {
+		exprs = append(exprs, variant.Constraint)
+	}
+
+	b := newBDD(exprs...)
+	nodes := make([]int, len(variants))
+	for i, expr := range exprs {
+		nodes[i] = b.node(expr)
+	}
+
+	covered := bddFalse
+	for i, x := range nodes {
+		for _, y := range nodes[:i] {
+			if both := b.and(x, y); both != bddFalse {
+				return selectionError(b.tags, variants, b.witness(both))
+			}
+		}
+
+		covered = b.or(covered, x)
+	}
+
+	if none := b.not(covered); none != bddFalse {
+		return selectionError(b.tags, variants, b.witness(none))
+	}
+
+	return nil
+}
+
+// selectionError returns the error of the given set of tags that doesn't
+// select exactly one variant.
+func selectionError(tags []string, variants []*Variant, isSet func(tag string) bool) error {
+	selected := make([]string, 0, 2)
+	for _, variant := range variants {
+		if variant.Constraint == nil || variant.Constraint.Eval(isSet) {
+			selected = append(selected, fmt.Sprintf("%q", variant.Suffix))
+		}
+	}
+
+	setTags := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if isSet(tag) {
+			setTags = append(setTags, tag)
+		}
+	}
+
+	return fmt.Errorf("the build tags [%v] select %v variants instead of one: %v",
+		strings.Join(setTags, " "), len(selected), strings.Join(selected, ", "))
+}
+
+// exprTags returns the build tags used by the given constraints, in order of
+// appearance.
+func exprTags(exprs ...constraint.Expr) []string {
+	var tags []string
+	set := make(map[string]struct{})
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+
+		// Eval visits every tag of the constraint.
+		expr.Eval(func(tag string) bool {
+			if _, isKnown := set[tag]; !isKnown {
+				set[tag] = struct{}{}
+				tags = append(tags, tag)
+			}
+			return false
+		})
+	}
+
+	return tags
+}
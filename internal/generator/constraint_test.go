@@ -0,0 +1,158 @@
+package generator
+
+import (
+	"fmt"
+	"go/build/constraint"
+	"strings"
+	"testing"
+)
+
+func parseConstraint(t *testing.T, line string) constraint.Expr {
+	t.Helper()
+
+	expr, err := constraint.Parse("//go:build " + line)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return expr
+}
+
+func TestNot(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"a", "!a"},
+		{"!a", "a"},
+		{"a && b", "!a || !b"},
+		{"a || b", "!a && !b"},
+		{"a && (b || !c)", "!a || (!b && c)"},
+	}
+
+	for _, test := range tests {
+		actual := Not(parseConstraint(t, test.expr)).String()
+		if actual != test.expected {
+			t.Errorf("Not(%v) = %v, expected %v", test.expr, actual, test.expected)
+		}
+	}
+}
+
+func TestOrdered(t *testing.T) {
+	exprs := Ordered(Tag("info"), Or(Tag("debug"), Tag("verbose")), Tag("trace"))
+
+	expected := []string{
+		"info && !debug && !verbose && !trace",
+		"(debug || verbose) && !trace",
+		"trace",
+	}
+	for i, expr := range exprs {
+		if expr.String() != expected[i] {
+			t.Errorf("constraint %v = %v, expected %v", i, expr, expected[i])
+		}
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"!(a || b)", "!a && !b"},
+		{"!(!a || b)", "a && !b"},
+		{"a && (a || b)", "a"},
+		{"(a && b) || (a && !b)", "a"},
+		{"a || b", "a || b"},
+		{"a || !a", "a || !a"},
+		{"a && !a", "a && !a"},
+	}
+
+	for _, test := range tests {
+		actual := simplify(parseConstraint(t, test.expr)).String()
+		if actual != test.expected {
+			t.Errorf("simplify(%v) = %v, expected %v", test.expr, actual, test.expected)
+		}
+	}
+}
+
+func TestComplete(t *testing.T) {
+	prod := &Variant{Suffix: ".prod"}
+	variants := []*Variant{
+		{Suffix: ".info", Constraint: parseConstraint(t, "info && !debug")},
+		{Suffix: ".debug", Constraint: parseConstraint(t, "debug")},
+		prod,
+	}
+
+	result, err := complete(variants)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual := result[2].Constraint.String(); actual != "!info && !debug" {
+		t.Errorf("unexpected default constraint: %v", actual)
+	}
+	if prod.Constraint != nil {
+		t.Errorf("the default variant is modified")
+	}
+
+	_, err = complete([]*Variant{{Suffix: ".a"}, {Suffix: ".b"}})
+	if err == nil {
+		t.Errorf("variants without constraint are accepted")
+	}
+}
+
+func TestCheckExclusive(t *testing.T) {
+	// 22 tags, a tag and an alias per level.
+	levels := make([]constraint.Expr, 11)
+	for i := range levels {
+		levels[i] = Or(Tag(fmt.Sprintf("level_%v", i)), Tag(fmt.Sprintf("alias_%v", i)))
+	}
+	levels = Ordered(levels...)
+
+	variants := make([]*Variant, 0, len(levels)+1)
+	for i, level := range levels {
+		variants = append(variants, &Variant{Suffix: fmt.Sprint(i), Constraint: level})
+	}
+	variants = append(variants, &Variant{Suffix: ".prod"})
+
+	variants, err := complete(variants)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = checkExclusive(variants)
+	if err != nil {
+		t.Errorf("%v tags: %v", len(exprTags(levels...)), err)
+	}
+
+	tests := []struct {
+		name     string
+		variants []*Variant
+		expected string
+	}{
+		{
+			name: "overlap",
+			variants: []*Variant{
+				{Suffix: ".a", Constraint: parseConstraint(t, "a || c")},
+				{Suffix: ".b", Constraint: parseConstraint(t, "b || c")},
+				{Suffix: ".prod", Constraint: parseConstraint(t, "!a && !b && !c")},
+			},
+			expected: `the build tags [c] select 2 variants instead of one: ".a", ".b"`,
+		},
+		{
+			name: "gap",
+			variants: []*Variant{
+				{Suffix: ".a", Constraint: parseConstraint(t, "a && !b")},
+				{Suffix: ".prod", Constraint: parseConstraint(t, "!a && !b")},
+			},
+			expected: "the build tags [b] select 0 variants instead of one: ",
+		},
+	}
+
+	for _, test := range tests {
+		err := checkExclusive(test.variants)
+		if err == nil || !strings.HasPrefix(err.Error(), test.expected) {
+			t.Errorf("%v: unexpected error: %v", test.name, err)
+		}
+	}
+}
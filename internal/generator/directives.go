@@ -0,0 +1,154 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+const directivePrefix = "//debuggo:"
+
+// Directives are the generation directives of a declaration. They're set with
+// comments in the doc of the declaration:
+//
+//	//debuggo:keep
+//	//debuggo:strip
+//	//debuggo:level=debug
+//	//debuggo:template=Level
+//
+// A directive on a grouped declaration (var, const, type) applies to all of its
+// specs, unless a spec set its own directives.
+type Directives struct {
+	// Keep prevents every pass from editing or removing the declaration, it's
+	// used for helpers that must be available in every variant.
+	Keep bool
+	// Strip makes every pass remove the declaration (or its body), even if it
+	// is exported or enabled by the variant filter.
+	Strip bool
+	// Level is the name of the level that enables the function, it's used by
+	// variant filters instead of the function name. It's only valid on
+	// functions and methods, see checkDirectives.
+	Level string
+	// Template is the placeholder of a template declaration, the declaration
+	// is copied per level by ExpandTemplates.
+	Template string
+}
+
+// parseDirectives returns the directives found in the given comment groups.
+// Later groups override the directives of the previous ones.
+func parseDirectives(groups ...*ast.CommentGroup) Directives {
+	directives := Directives{}
+
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+
+		for _, comment := range group.List {
+			if !strings.HasPrefix(comment.Text, directivePrefix) {
+				continue
+			}
+
+			directive := strings.TrimSpace(strings.TrimPrefix(comment.Text, directivePrefix))
+			switch {
+			case directive == "keep":
+				directives.Keep, directives.Strip = true, false
+
+			case directive == "strip":
+				directives.Keep, directives.Strip = false, true
+
+			case strings.HasPrefix(directive, "level="):
+				directives.Level = strings.TrimPrefix(directive, "level=")
+
+			case strings.HasPrefix(directive, "template="):
+				directives.Template = strings.TrimPrefix(directive, "template=")
+			}
+		}
+	}
+
+	return directives
+}
+
+// FuncDirectives returns the directives of the given function or method.
+func FuncDirectives(funcDecl *ast.FuncDecl) Directives {
+	return parseDirectives(funcDecl.Doc)
+}
+
+// SpecDirectives returns the directives of the given spec of a var, const or
+// type declaration.
+func SpecDirectives(genDecl *ast.GenDecl, spec ast.Spec) Directives {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return parseDirectives(genDecl.Doc, s.Doc)
+	case *ast.ValueSpec:
+		return parseDirectives(genDecl.Doc, s.Doc)
+	default:
+		return parseDirectives(genDecl.Doc)
+	}
+}
+
+// checkDirectives returns an error if a directive of the given file is
+// unknown, lacks its value, or if a var, const or type declaration has a
+// //debuggo:level directive. Only the bodies of the functions are removed by
+// level, the other declarations can't have one.
+func checkDirectives(fset *token.FileSet, file *ast.File) error {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if !strings.HasPrefix(comment.Text, directivePrefix) {
+				continue
+			}
+
+			directive := strings.TrimSpace(strings.TrimPrefix(comment.Text, directivePrefix))
+			name, value, hasValue := strings.Cut(directive, "=")
+			switch name {
+			case "keep", "strip":
+				if hasValue {
+					return fmt.Errorf("%v: the %v directive has no value", fset.Position(comment.Pos()), name)
+				}
+
+			case "level", "template":
+				if strings.TrimSpace(value) == "" {
+					return fmt.Errorf("%v: the %v directive requires a value (%v%v=...)",
+						fset.Position(comment.Pos()), name, directivePrefix, name)
+				}
+
+			default:
+				return fmt.Errorf("%v: unknown directive %q, the directives are keep, strip, level= and template=",
+					fset.Position(comment.Pos()), comment.Text)
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl {
+			continue
+		}
+
+		groups := []*ast.CommentGroup{genDecl.Doc}
+		for _, spec := range genDecl.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				groups = append(groups, s.Doc)
+			case *ast.ValueSpec:
+				groups = append(groups, s.Doc)
+			}
+		}
+
+		for _, group := range groups {
+			if group == nil {
+				continue
+			}
+
+			for _, comment := range group.List {
+				if strings.HasPrefix(comment.Text, directivePrefix+"level=") {
+					return fmt.Errorf("%v: the level directive only applies to functions, not to %v declarations",
+						fset.Position(comment.Pos()), genDecl.Tok)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestCheckDirectives(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		err  string
+	}{
+		{
+			name: "function",
+			src: `package p
+
+//debuggo:level=debug
+func trace() {}
+`,
+		},
+		{
+			name: "var",
+			src: `package p
+
+//debuggo:level=debug
+var verbose = true
+`,
+			err: "p.go:3:1: the level directive only applies to functions, not to var declarations",
+		},
+		{
+			name: "type spec",
+			src: `package p
+
+type (
+	//debuggo:keep
+	//debuggo:level=debug
+	tracer struct{}
+)
+`,
+			err: "p.go:5:2: the level directive only applies to functions, not to type declarations",
+		},
+		{
+			name: "unknown directive",
+			src: `package p
+
+//debuggo:kepe
+func Always() int { return 1 }
+`,
+			err: `p.go:3:1: unknown directive "//debuggo:kepe"`,
+		},
+		{
+			name: "empty level",
+			src: `package p
+
+//debuggo:level=
+func trace() {}
+`,
+			err: "p.go:3:1: the level directive requires a value",
+		},
+		{
+			name: "keep with a value",
+			src: `package p
+
+//debuggo:keep=true
+func Always() int { return 1 }
+`,
+			err: "p.go:3:1: the keep directive has no value",
+		},
+		{
+			name: "other directives",
+			src: `package p
+
+//debuggo:keep
+const size = 1
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "p.go", test.src, parser.ParseComments)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = checkDirectives(fset, file)
+			switch {
+			case test.err == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+				t.Errorf("error %v, want %q", err, test.err)
+			}
+		})
+	}
+}
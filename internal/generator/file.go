@@ -0,0 +1,316 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// File define a parsed Go file edited by the passes of a variant.
+//
+// Comments are tracked using an ast.CommentMap built when the file is parsed,
+// they follow the nodes they're attached to when passes move, copy or remove
+// nodes.
+type File struct {
+	name string
+	fset *token.FileSet
+	ast  *ast.File
+	cmap ast.CommentMap
+}
+
+func parseFile(path string) (*File, error) {
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkDirectives(fset, astFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &File{
+		name: filepath.Base(path),
+		fset: fset,
+		ast:  astFile,
+		cmap: ast.NewCommentMap(fset, astFile, astFile.Comments),
+	}, nil
+}
+
+// Name returns the name of the source file.
+func (f *File) Name() string {
+	return f.name
+}
+
+// FileSet returns the token.FileSet of the file.
+func (f *File) FileSet() *token.FileSet {
+	return f.fset
+}
+
+// AST returns the *ast.File of the file.
+func (f *File) AST() *ast.File {
+	return f.ast
+}
+
+// CommentMap returns the comments of the file associated with the node they
+// belong to. Passes that replace a node should move its comments to the new
+// node.
+func (f *File) CommentMap() ast.CommentMap {
+	return f.cmap
+}
+
+// Fprint "pretty-print" the file to output.
+//
+// Declarations are printed one by one, each one with its doc and the comments
+// attached to its nodes. Thus, comments of removed nodes are dropped and the
+// comments of moved or new declarations can't leak into their neighbours. The
+// //debuggo: and //go:generate directives of the source file are dropped too.
+func (f *File) Fprint(output io.Writer) error {
+	buf := &bytes.Buffer{}
+
+	header := &ast.File{
+		Doc:     f.ast.Doc,
+		Package: f.ast.Package,
+		Name:    f.ast.Name,
+	}
+	err := f.fprintNode(buf, header, f.headerComments())
+	if err != nil {
+		return err
+	}
+
+	docs := make(map[*ast.CommentGroup]ast.Decl, len(f.ast.Decls))
+	for _, decl := range f.ast.Decls {
+		if doc := declDoc(decl); doc != nil {
+			docs[doc] = decl
+		}
+	}
+
+	for _, decl := range f.ast.Decls {
+		buf.WriteString("\n\n")
+
+		err = f.fprintNode(buf, decl, f.declComments(decl, docs))
+		if err != nil {
+			return err
+		}
+	}
+	buf.WriteString("\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err == nil {
+		src, err = stripDirectives(src)
+	}
+	if err != nil {
+		return fmt.Errorf("%v: invalid generated code: %v", f.name, err)
+	}
+
+	_, err = output.Write(src)
+	return err
+}
+
+// fprintNode prints the given node and comments. The printer ignores comments
+// that precede the node (and its doc), they're written as is.
+func (f *File) fprintNode(output io.Writer, node ast.Node, comments []*ast.CommentGroup) error {
+	begin := node.Pos()
+	switch n := node.(type) {
+	case *ast.File:
+		if n.Doc != nil {
+			begin = n.Doc.Pos()
+		}
+	case ast.Decl:
+		if doc := declDoc(n); doc != nil {
+			begin = doc.Pos()
+		}
+	}
+
+	i := 0
+	for ; i < len(comments) && comments[i].End() < begin; i++ {
+		for _, comment := range comments[i].List {
+			_, err := fmt.Fprintln(output, comment.Text)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := fmt.Fprintln(output)
+		if err != nil {
+			return err
+		}
+	}
+
+	return printer.Fprint(output, f.fset, &printer.CommentedNode{
+		Node:     node,
+		Comments: comments[i:],
+	})
+}
+
+// headerComments returns the comments that precede the package clause, build
+// constraints of the source file are dropped.
+func (f *File) headerComments() []*ast.CommentGroup {
+	comments := make([]*ast.CommentGroup, 0, 1)
+	for _, group := range f.ast.Comments {
+		if group.Pos() >= f.ast.Name.Pos() {
+			break
+		}
+
+		if isBuildConstraint(group) {
+			continue
+		}
+
+		comments = append(comments, group)
+	}
+
+	return comments
+}
+
+// declComments returns the comments attached to the given declaration, sorted
+// by position. docs maps the doc comment of every declaration to its owner.
+func (f *File) declComments(decl ast.Decl, docs map[*ast.CommentGroup]ast.Decl) []*ast.CommentGroup {
+	comments := f.cmap.Filter(decl).Comments()
+	if doc := declDoc(decl); doc != nil {
+		comments = append(comments, doc)
+	}
+
+	seen := make(map[*ast.CommentGroup]struct{}, len(comments))
+	result := make([]*ast.CommentGroup, 0, len(comments))
+	for _, group := range comments {
+		if _, isSeen := seen[group]; isSeen {
+			continue
+		}
+		seen[group] = struct{}{}
+
+		// Doc comments belong to a single declaration.
+		if owner, isDoc := docs[group]; isDoc && owner != decl {
+			continue
+		}
+
+		if isBuildConstraint(group) {
+			continue
+		}
+
+		result = append(result, group)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Pos() < result[j].Pos()
+	})
+
+	return result
+}
+
+func (f *File) write(path string, expr constraint.Expr) error {
+	buf := &bytes.Buffer{}
+	if expr != nil {
+		lines, err := constraintLines(expr)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(buf, "%v\n\n", strings.Join(lines, "\n"))
+	}
+
+	err := f.Fprint(buf)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0755)
+}
+
+func declDoc(decl ast.Decl) *ast.CommentGroup {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return d.Doc
+	case *ast.GenDecl:
+		return d.Doc
+	}
+
+	return nil
+}
+
+// sourceDirectives are the prefixes of the comments that only apply to the
+// source files, they're dropped from the generated files.
+var sourceDirectives = []string{directivePrefix, "//go:generate"}
+
+func isSourceDirective(comment *ast.Comment) bool {
+	for _, prefix := range sourceDirectives {
+		if strings.HasPrefix(comment.Text, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stripDirectives removes the lines of the source directives from the given
+// formatted source, along with the empty comment lines that separated them
+// from the rest of their comment group.
+func stripDirectives(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := bytes.Split(src, []byte("\n"))
+	ownLine := func(comment *ast.Comment) (int, bool) {
+		line := fset.Position(comment.Pos()).Line
+		return line, string(bytes.TrimSpace(lines[line-1])) == comment.Text
+	}
+
+	removed := make(map[int]struct{})
+	for _, group := range astFile.Comments {
+		stripped := false
+		for _, comment := range group.List {
+			if line, isOwnLine := ownLine(comment); isOwnLine && isSourceDirective(comment) {
+				removed[line] = struct{}{}
+				stripped = true
+			}
+		}
+
+		for i := len(group.List) - 1; stripped && i >= 0; i-- {
+			line, isOwnLine := ownLine(group.List[i])
+			if _, isRemoved := removed[line]; isRemoved {
+				continue
+			}
+			if !isOwnLine || group.List[i].Text != "//" {
+				break
+			}
+			removed[line] = struct{}{}
+		}
+	}
+
+	if len(removed) == 0 {
+		return src, nil
+	}
+
+	result := make([][]byte, 0, len(lines)-len(removed))
+	for i, line := range lines {
+		if _, isRemoved := removed[i+1]; !isRemoved {
+			result = append(result, line)
+		}
+	}
+
+	return format.Source(bytes.Join(result, []byte("\n")))
+}
+
+func isBuildConstraint(group *ast.CommentGroup) bool {
+	for _, comment := range group.List {
+		if strings.HasPrefix(comment.Text, "// +build") ||
+			strings.HasPrefix(comment.Text, "//go:build") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,215 @@
+// Package generator generates the conditionally compiled variants of a Go
+// package.
+//
+// Every Go file of the source directory is edited once per variant and written
+// into the output directory, guarded by the build constraint of the variant.
+// The constraint of the default variant is derived from the others, and the
+// generator checks that exactly one variant is selected for any set of tags.
+// Files of the common directory are copied as is and shared by all variants.
+//
+// Declarations of the source files can control how they're generated using
+// directive comments, see Directives.
+package generator
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Generator generates the variants of a source package.
+type Generator struct {
+	srcDir    string
+	outputDir string
+	commonDir string
+	tags      []string
+	variants  []*Variant
+	include   map[string]struct{}
+	exclude   map[string]struct{}
+}
+
+// New returns a new Generator configured with the given options. The source
+// directory is required, variants default to a debug variant guarded by the
+// given tags (or "debug") and its stubbed prod counterpart.
+func New(options ...Option) (*Generator, error) {
+	gen := &Generator{
+		outputDir: ".",
+		include:   make(map[string]struct{}),
+		exclude:   make(map[string]struct{}),
+	}
+
+	for _, option := range options {
+		if err := option(gen); err != nil {
+			return nil, err
+		}
+	}
+
+	if gen.srcDir == "" {
+		return nil, fmt.Errorf("the source directory is required")
+	}
+
+	if len(gen.variants) == 0 {
+		tags := gen.tags
+		if len(tags) == 0 {
+			tags = []string{"debug"}
+		}
+
+		gen.variants = []*Variant{Debug(tags...), Prod()}
+	}
+
+	variants, err := complete(gen.variants)
+	if err != nil {
+		return nil, err
+	}
+	gen.variants = variants
+
+	err = checkExclusive(gen.variants)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen, nil
+}
+
+// Start generates every variant of the source files and copy the common files
+// into the output directory.
+func (g *Generator) Start() error {
+	err := os.MkdirAll(g.outputDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	srcFiles, err := g.sourceFiles()
+	if err != nil {
+		return err
+	}
+
+	if g.commonDir != "" {
+		err = g.copyCommonFiles(srcFiles)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range srcFiles {
+		for _, variant := range g.variants {
+			err = g.generate(name, variant)
+			if err != nil {
+				return fmt.Errorf("%v: %v", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) generate(name string, variant *Variant) error {
+	// Every variant edits its own copy of the AST.
+	file, err := parseFile(filepath.Join(g.srcDir, name))
+	if err != nil {
+		return err
+	}
+
+	for _, pass := range variant.Passes {
+		pass(file)
+	}
+
+	return file.write(
+		filepath.Join(g.outputDir, addSuffix(name, variant.Suffix)),
+		variant.Constraint,
+	)
+}
+
+func (g *Generator) sourceFiles() ([]string, error) {
+	names, err := goFiles(g.srcDir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, excluded := g.exclude[name]; excluded {
+			continue
+		}
+
+		if _, included := g.include[name]; len(g.include) != 0 && !included {
+			continue
+		}
+
+		result = append(result, name)
+	}
+
+	return result, nil
+}
+
+func (g *Generator) copyCommonFiles(srcFiles []string) error {
+	names, err := goFiles(g.commonDir)
+	if err != nil {
+		return err
+	}
+
+	generated := make(map[string]struct{}, len(srcFiles))
+	if sameDir(g.commonDir, g.srcDir) {
+		for _, name := range srcFiles {
+			generated[name] = struct{}{}
+		}
+	}
+
+	for _, name := range names {
+		// Source files have their own variants.
+		if _, isGenerated := generated[name]; isGenerated {
+			continue
+		}
+
+		src, err := ioutil.ReadFile(filepath.Join(g.commonDir, name))
+		if err != nil {
+			return err
+		}
+
+		err = ioutil.WriteFile(filepath.Join(g.outputDir, name), src, 0755)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// goFiles returns the name of the non-test Go files of the given directory.
+func goFiles(dir string) ([]string, error) {
+	filesInfo, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(filesInfo))
+	for _, fileInfo := range filesInfo {
+		name := fileInfo.Name()
+		if fileInfo.IsDir() ||
+			filepath.Ext(name) != ".go" ||
+			strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+func sameDir(a, b string) bool {
+	a, errA := filepath.Abs(a)
+	b, errB := filepath.Abs(b)
+
+	return errA == nil && errB == nil && a == b
+}
+
+func addSuffix(filename, suffix string) string {
+	ext := filepath.Ext(filename)
+	extLen := len(ext)
+	nameLen := len(filename)
+
+	return filename[:nameLen-extLen] + suffix + ext
+}
@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// Option configure a Generator.
+type Option func(*Generator) error
+
+// SrcDir sets the directory that contains the source package.
+func SrcDir(dir string) Option {
+	return func(g *Generator) error {
+		if dir == "" {
+			return fmt.Errorf("the source directory is empty")
+		}
+
+		g.srcDir = dir
+		return nil
+	}
+}
+
+// OutputDir sets the directory where generated files are written.
+func OutputDir(dir string) Option {
+	return func(g *Generator) error {
+		if dir == "" {
+			return fmt.Errorf("the output directory is empty")
+		}
+
+		g.outputDir = dir
+		return nil
+	}
+}
+
+// CommonDir sets a directory whose Go files are copied as is into the output
+// directory. Source files are never copied, even if the common directory is
+// the source directory.
+func CommonDir(dir string) Option {
+	return func(g *Generator) error {
+		g.commonDir = dir
+		return nil
+	}
+}
+
+// Tags sets the build tags that enable the default debug variant. It has no
+// effect if the variants are set using the Variants option.
+func Tags(tags ...string) Option {
+	return func(g *Generator) error {
+		g.tags = append(g.tags, tags...)
+		return nil
+	}
+}
+
+// Variants sets the variants generated for every source file.
+func Variants(variants ...*Variant) Option {
+	return func(g *Generator) error {
+		g.variants = append(g.variants, variants...)
+		return nil
+	}
+}
+
+// Include restricts the generated source files to the given file names.
+func Include(names ...string) Option {
+	return func(g *Generator) error {
+		for _, name := range names {
+			g.include[name] = struct{}{}
+		}
+		return nil
+	}
+}
+
+// Exclude skips the source files with the given names.
+func Exclude(names ...string) Option {
+	return func(g *Generator) error {
+		for _, name := range names {
+			g.exclude[name] = struct{}{}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,741 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/negrel/asttk/pkg/inspector"
+)
+
+// Pass is a step of a variant pipeline, it edits the given file in place.
+type Pass func(file *File)
+
+// Inspect returns a Pass that inspects the file AST with the given inspectors.
+func Inspect(inspectors ...inspector.Inspector) Pass {
+	return func(file *File) {
+		inspector.New(inspectors...).Inspect(file.AST())
+	}
+}
+
+// RemoveFuncBodies returns a Pass that empties the body of every function
+// declaration that isn't enabled by the given filter. The filter receives the
+// level set by the //debuggo:level directive of the function or, lacking one,
+// its name. A nil filter empties every function.
+//
+// Functions with a //debuggo:keep directive are never emptied, those with a
+// //debuggo:strip directive are always emptied.
+func RemoveFuncBodies(enabled func(name string) bool) Pass {
+	return func(file *File) {
+		for _, decl := range file.AST().Decls {
+			funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+			if !isFuncDecl || funcDecl.Body == nil {
+				continue
+			}
+
+			directives := FuncDirectives(funcDecl)
+			if directives.Keep {
+				continue
+			}
+
+			if enabled != nil && !directives.Strip {
+				name := funcDecl.Name.Name
+				if directives.Level != "" {
+					name = directives.Level
+				}
+
+				if enabled(name) {
+					continue
+				}
+			}
+
+			// Replace the body to drop the comments attached to it.
+			funcDecl.Body = &ast.BlockStmt{
+				Lbrace: funcDecl.Body.Lbrace,
+				Rbrace: funcDecl.Body.Lbrace,
+			}
+		}
+	}
+}
+
+// ReturnZeroValues is a Pass that adds a return statement with the zero value
+// of every result to the empty functions, such as those emptied by
+// RemoveFuncBodies.
+func ReturnZeroValues(file *File) {
+	ReturnValues(nil)(file)
+}
+
+// ReturnValues returns a Pass that adds a return statement to the empty
+// functions. The value of each result is returned by the given function, the
+// zero value of the result type is used if it returns nil (or if value is nil).
+func ReturnValues(value func(funcDecl *ast.FuncDecl, result ast.Expr) ast.Expr) Pass {
+	return func(file *File) {
+		for _, decl := range file.AST().Decls {
+			funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+			if !isFuncDecl || funcDecl.Body == nil || len(funcDecl.Body.List) != 0 ||
+				funcDecl.Type.Results == nil || len(funcDecl.Type.Results.List) == 0 {
+				continue
+			}
+
+			results := make([]ast.Expr, 0, len(funcDecl.Type.Results.List))
+			for _, field := range funcDecl.Type.Results.List {
+				var result ast.Expr
+				if value != nil {
+					result = value(funcDecl, field.Type)
+				}
+				if result == nil {
+					result = ZeroValue(field.Type)
+				}
+
+				for i := 0; i < len(field.Names) || i == 0; i++ {
+					results = append(results, result)
+				}
+			}
+
+			funcDecl.Body.List = []ast.Stmt{
+				&ast.ReturnStmt{Results: results},
+			}
+		}
+	}
+}
+
+// ZeroValue returns a constant expression of the zero value of the given type
+// expression.
+func ZeroValue(typ ast.Expr) ast.Expr {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "bool":
+			return ast.NewIdent("false")
+
+		case "string":
+			return &ast.BasicLit{Kind: token.STRING, Value: `""`}
+
+		case "int", "int8", "int16", "int32", "int64",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+			"float32", "float64", "complex64", "complex128",
+			"byte", "rune":
+			return &ast.BasicLit{Kind: token.INT, Value: "0"}
+
+		case "error", "any":
+			return ast.NewIdent("nil")
+		}
+
+	case *ast.ParenExpr:
+		return ZeroValue(t.X)
+
+	case *ast.StarExpr, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.InterfaceType:
+		return ast.NewIdent("nil")
+
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return ast.NewIdent("nil")
+		}
+	}
+
+	// Named, struct and array types: *new(T)
+	return &ast.StarExpr{
+		X: &ast.CallExpr{
+			Fun:  ast.NewIdent("new"),
+			Args: []ast.Expr{typ},
+		},
+	}
+}
+
+// RenameFuncParams is a Pass that renames every function parameter to the
+// blank identifier. Declarations with a //debuggo:keep directive are left
+// unchanged.
+func RenameFuncParams(file *File) {
+	for _, decl := range file.AST().Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if !FuncDirectives(d).Keep {
+				ast.Inspect(d, renameFuncParams)
+			}
+
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if !SpecDirectives(d, spec).Keep {
+					ast.Inspect(spec, renameFuncParams)
+				}
+			}
+		}
+	}
+}
+
+func renameFuncParams(node ast.Node) (recursive bool) {
+	recursive = true
+
+	funcType, isFuncType := node.(*ast.FuncType)
+	if !isFuncType {
+		return
+	}
+
+	for _, params := range funcType.Params.List {
+		for i := range params.Names {
+			params.Names[i] = ast.NewIdent("_")
+		}
+	}
+
+	return false
+}
+
+// ForwardVariadics is a Pass that fixes the calls passing the variadic
+// parameter of the enclosing function as the last argument of a variadic
+// function of the file without "...": f(args) becomes f(args...). Otherwise,
+// the callee receives a single argument containing the whole slice.
+func ForwardVariadics(file *File) {
+	for _, decl := range file.AST().Decls {
+		funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+		if !isFuncDecl || funcDecl.Body == nil {
+			continue
+		}
+
+		variadic := variadicParam(funcDecl.Type)
+		if variadic == nil {
+			continue
+		}
+
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			callExpr, isCallExpr := node.(*ast.CallExpr)
+			if !isCallExpr || callExpr.Ellipsis.IsValid() || len(callExpr.Args) == 0 {
+				return true
+			}
+
+			last, isIdent := callExpr.Args[len(callExpr.Args)-1].(*ast.Ident)
+			if !isIdent || last.Obj == nil || last.Obj != variadic.Obj {
+				return true
+			}
+
+			if isVariadicFunc(callExpr.Fun, len(callExpr.Args)) {
+				callExpr.Ellipsis = last.End()
+			}
+
+			return true
+		})
+	}
+}
+
+// variadicParam returns the name of the variadic parameter of the given
+// function type, if any.
+func variadicParam(funcType *ast.FuncType) *ast.Ident {
+	params := funcType.Params.List
+	if len(params) == 0 {
+		return nil
+	}
+
+	last := params[len(params)-1]
+	if _, isEllipsis := last.Type.(*ast.Ellipsis); !isEllipsis || len(last.Names) != 1 {
+		return nil
+	}
+
+	return last.Names[0]
+}
+
+// isVariadicFunc reports whether fun is a function declared in the file whose
+// variadic parameter is at position nArgs.
+func isVariadicFunc(fun ast.Expr, nArgs int) bool {
+	ident, isIdent := fun.(*ast.Ident)
+	if !isIdent || ident.Obj == nil {
+		return false
+	}
+
+	funcDecl, isFuncDecl := ident.Obj.Decl.(*ast.FuncDecl)
+	if !isFuncDecl || variadicParam(funcDecl.Type) == nil {
+		return false
+	}
+
+	n := 0
+	for _, field := range funcDecl.Type.Params.List {
+		n += len(field.Names)
+	}
+
+	return n == nArgs
+}
+
+// RemoveUnusedImports is a Pass that removes the imports that are no longer
+// used by the file.
+func RemoveUnusedImports(file *File) {
+	astFile := file.AST()
+
+	used := make(map[string]struct{})
+	ast.Inspect(astFile, func(node ast.Node) bool {
+		selector, isSelector := node.(*ast.SelectorExpr)
+		if !isSelector {
+			return true
+		}
+
+		// Package identifiers are never resolved by the parser.
+		if ident, isIdent := selector.X.(*ast.Ident); isIdent && ident.Obj == nil {
+			used[ident.Name] = struct{}{}
+		}
+
+		return true
+	})
+
+	decls := astFile.Decls[:0]
+	for _, decl := range astFile.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.IMPORT {
+			decls = append(decls, decl)
+			continue
+		}
+
+		specs := genDecl.Specs[:0]
+		for _, spec := range genDecl.Specs {
+			if _, isUsed := used[importName(spec.(*ast.ImportSpec))]; isUsed {
+				specs = append(specs, spec)
+			}
+		}
+		genDecl.Specs = specs
+
+		// Drop the import declaration if nothing is imported anymore.
+		if len(specs) != 0 {
+			decls = append(decls, decl)
+		}
+	}
+	astFile.Decls = decls
+
+	imports := astFile.Imports[:0]
+	for _, spec := range astFile.Imports {
+		if _, isUsed := used[importName(spec)]; isUsed {
+			imports = append(imports, spec)
+		}
+	}
+	astFile.Imports = imports
+}
+
+// importName returns the name used to refer to the imported package.
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+
+	path := strings.Trim(spec.Path.Value, "\"")
+	name := path[strings.LastIndex(path, "/")+1:]
+
+	// gopkg.in/yaml.v3 is imported as yaml
+	if i := strings.Index(name, "."); i != -1 && strings.HasPrefix(path, "gopkg.in/") {
+		name = name[:i]
+	}
+
+	return name
+}
+
+// RemoveUnexportedDecls is a Pass that removes every unexported declaration.
+// Declarations with a //debuggo:keep directive are kept, those with a
+// //debuggo:strip directive are removed even if they're exported.
+//
+// The values of the kept variables and constants may refer to removed
+// declarations of the file: a variable with an explicit type gets its zero
+// value, the other ones keep the declarations they refer to, along with the
+// methods of the kept types. The functions with a //debuggo:keep directive
+// keep the declarations their body refers to, and the functions they call keep
+// their body as well. The bodies of the other kept functions are left to
+// RemoveFuncBodies.
+func RemoveUnexportedDecls(file *File) {
+	astFile := file.AST()
+	deps := newDeclDeps(astFile)
+
+	decls := astFile.Decls[:0]
+	for _, decl := range astFile.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if !deps.kept[d.Name] {
+				continue
+			}
+
+		case *ast.GenDecl:
+			if !deps.filterGenDecl(d) {
+				continue
+			}
+		}
+
+		decls = append(decls, decl)
+	}
+	astFile.Decls = decls
+}
+
+// declDeps tracks the top-level declarations of a file kept by
+// RemoveUnexportedDecls.
+type declDeps struct {
+	kept map[*ast.Ident]bool
+	// removable contains the nodes referenced by the removed declarations, by
+	// name.
+	removable map[string]removableDecl
+	// methods contains the removed methods, by receiver type name.
+	methods map[string][]*ast.FuncDecl
+	// bodies contains the kept functions whose body is kept too.
+	bodies map[*ast.FuncDecl]bool
+}
+
+type removableDecl struct {
+	name  *ast.Ident
+	nodes []ast.Node
+	// funcDecl is the declaration of a removable function.
+	funcDecl *ast.FuncDecl
+}
+
+func newDeclDeps(astFile *ast.File) *declDeps {
+	deps := &declDeps{
+		kept:      make(map[*ast.Ident]bool),
+		bodies:    make(map[*ast.FuncDecl]bool),
+		removable: make(map[string]removableDecl),
+		methods:   make(map[string][]*ast.FuncDecl),
+	}
+
+	var values, typed []*ast.ValueSpec
+	var kept []*ast.FuncDecl
+	for _, decl := range astFile.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			directives := FuncDirectives(d)
+			switch {
+			case directives.Keep:
+				deps.kept[d.Name] = true
+				kept = append(kept, d)
+			case isExportedFunc(d) && !directives.Strip:
+				deps.kept[d.Name] = true
+			case d.Recv != nil:
+				recv := recvTypeName(d)
+				deps.methods[recv] = append(deps.methods[recv], d)
+			default:
+				deps.removable[d.Name.Name] = removableDecl{name: d.Name, nodes: []ast.Node{d.Type}, funcDecl: d}
+			}
+
+		case *ast.GenDecl:
+			blank := d.Tok == token.CONST && hasImplicitValues(d)
+
+			for _, spec := range d.Specs {
+				directives := SpecDirectives(d, spec)
+				keep := func(name *ast.Ident) bool {
+					return directives.Keep || (ast.IsExported(name.Name) && !directives.Strip)
+				}
+
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if keep(s.Name) {
+						deps.kept[s.Name] = true
+					} else {
+						deps.removable[s.Name.Name] = removableDecl{name: s.Name, nodes: []ast.Node{s}}
+					}
+
+				case *ast.ValueSpec:
+					isKept := false
+					for _, name := range s.Names {
+						if keep(name) {
+							deps.kept[name] = true
+							isKept = true
+						} else {
+							deps.removable[name.Name] = removableDecl{name: name, nodes: valueSpecNodes(s)}
+						}
+					}
+
+					// Removed constants are replaced with blank identifiers so
+					// the implicit values keep their iota.
+					if isKept || blank {
+						values = append(values, s)
+					}
+
+					// Typed variables that refer to removed declarations get
+					// their zero value.
+					if isKept && d.Tok == token.VAR && s.Type != nil {
+						typed = append(typed, s)
+					}
+				}
+			}
+		}
+	}
+
+	for _, spec := range typed {
+		if deps.refersToRemovable(spec.Values) {
+			spec.Values = nil
+		}
+	}
+
+	for _, spec := range values {
+		for _, node := range valueSpecNodes(spec) {
+			deps.require(node)
+		}
+	}
+
+	for _, funcDecl := range kept {
+		deps.requireBody(funcDecl)
+	}
+
+	return deps
+}
+
+// valueSpecNodes returns the type and the values of the given spec.
+func valueSpecNodes(spec *ast.ValueSpec) []ast.Node {
+	nodes := make([]ast.Node, 0, 1+len(spec.Values))
+	if spec.Type != nil {
+		nodes = append(nodes, spec.Type)
+	}
+	for _, value := range spec.Values {
+		nodes = append(nodes, value)
+	}
+
+	return nodes
+}
+
+// hasImplicitValues reports whether a spec of the given declaration repeats
+// the values of the previous one.
+func hasImplicitValues(d *ast.GenDecl) bool {
+	for _, spec := range d.Specs[1:] {
+		if valueSpec, isValueSpec := spec.(*ast.ValueSpec); isValueSpec && len(valueSpec.Values) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// refersToRemovable reports whether the given expressions refer to a
+// declaration that isn't kept.
+func (deps *declDeps) refersToRemovable(exprs []ast.Expr) bool {
+	result := false
+	for _, expr := range exprs {
+		deps.inspectRefs(expr, func(ref removableDecl) {
+			result = result || !deps.kept[ref.name]
+		})
+	}
+
+	return result
+}
+
+// require keeps the declarations the given node refers to, and theirs in turn.
+func (deps *declDeps) require(node ast.Node) {
+	deps.inspectRefs(node, deps.requireRef)
+}
+
+func (deps *declDeps) requireRef(ref removableDecl) {
+	if deps.kept[ref.name] {
+		return
+	}
+	deps.kept[ref.name] = true
+
+	for _, node := range ref.nodes {
+		deps.require(node)
+	}
+	for _, method := range deps.methods[ref.name.Name] {
+		deps.kept[method.Name] = true
+		deps.require(method.Type)
+	}
+}
+
+// requireBody keeps the declarations the body of the given kept function
+// refers to. The functions it calls keep their body too, they get a
+// //debuggo:keep directive so RemoveFuncBodies doesn't empty them.
+func (deps *declDeps) requireBody(funcDecl *ast.FuncDecl) {
+	if funcDecl.Body == nil || deps.bodies[funcDecl] {
+		return
+	}
+	deps.bodies[funcDecl] = true
+
+	deps.inspectRefs(funcDecl.Body, func(ref removableDecl) {
+		deps.requireRef(ref)
+
+		if ref.funcDecl != nil && !FuncDirectives(ref.funcDecl).Keep {
+			addKeepDirective(ref.funcDecl)
+			deps.requireBody(ref.funcDecl)
+		}
+	})
+}
+
+// addKeepDirective appends a //debuggo:keep directive to the doc of the given
+// function.
+func addKeepDirective(funcDecl *ast.FuncDecl) {
+	if funcDecl.Doc == nil {
+		funcDecl.Doc = &ast.CommentGroup{}
+	}
+
+	funcDecl.Doc.List = append(funcDecl.Doc.List, &ast.Comment{
+		Slash: funcDecl.Pos() - 1,
+		Text:  directivePrefix + "keep",
+	})
+}
+
+// inspectRefs calls fn for each identifier of the node that refers to a
+// removable declaration.
+func (deps *declDeps) inspectRefs(node ast.Node, fn func(ref removableDecl)) {
+	ast.Inspect(node, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.SelectorExpr:
+			deps.inspectRefs(n.X, fn)
+			return false
+
+		case *ast.Ident:
+			if ref, isRemovable := deps.removable[n.Name]; isRemovable {
+				fn(ref)
+			}
+		}
+
+		return true
+	})
+}
+
+// filterGenDecl removes the specs and names of the declaration that aren't
+// kept. It reports whether the declaration is still needed.
+func (deps *declDeps) filterGenDecl(d *ast.GenDecl) bool {
+	if d.Tok == token.IMPORT {
+		return true
+	}
+
+	blank := d.Tok == token.CONST && hasImplicitValues(d)
+
+	specs := d.Specs[:0]
+	isKept := false
+	for _, spec := range d.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			if !deps.kept[s.Name] {
+				continue
+			}
+
+		case *ast.ValueSpec:
+			if !deps.filterValueSpec(s, blank) {
+				if blank {
+					specs = append(specs, spec)
+				}
+				continue
+			}
+		}
+
+		specs = append(specs, spec)
+		isKept = true
+	}
+	d.Specs = specs
+
+	return isKept
+}
+
+// filterValueSpec removes the names of the spec that aren't kept, along with
+// their values. The names are replaced with blank identifiers instead if blank
+// is true or if they're assigned by a single multi-value expression. It
+// reports whether a name of the spec is kept.
+func (deps *declDeps) filterValueSpec(spec *ast.ValueSpec, blank bool) bool {
+	multiValue := len(spec.Values) == 1 && len(spec.Names) > 1
+
+	names := spec.Names[:0]
+	values := spec.Values[:0]
+	isKept := false
+	for i, name := range spec.Names {
+		switch {
+		case deps.kept[name]:
+			isKept = true
+		case blank || multiValue:
+			name = &ast.Ident{NamePos: name.NamePos, Name: "_"}
+		default:
+			continue
+		}
+
+		names = append(names, name)
+		if len(spec.Values) == len(spec.Names) {
+			values = append(values, spec.Values[i])
+		}
+	}
+	spec.Names = names
+	if !multiValue {
+		spec.Values = values
+	}
+
+	return isKept
+}
+
+// isExportedFunc reports whether the given function or method is exported.
+// Methods are exported if both their name and their receiver type are.
+func isExportedFunc(funcDecl *ast.FuncDecl) bool {
+	if !ast.IsExported(funcDecl.Name.Name) {
+		return false
+	}
+
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+		return true
+	}
+
+	return ast.IsExported(recvTypeName(funcDecl))
+}
+
+// recvTypeName returns the name of the receiver type of the given method, an
+// empty string if it's a function.
+func recvTypeName(funcDecl *ast.FuncDecl) string {
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+		return ""
+	}
+
+	return typeName(funcDecl.Recv.List[0].Type)
+}
+
+// typeName returns the name of the given type expression, without its
+// pointer, package and type arguments: both *pkg.List[T] and List are List.
+func typeName(typ ast.Expr) string {
+	for {
+		switch t := typ.(type) {
+		case *ast.StarExpr:
+			typ = t.X
+		case *ast.ParenExpr:
+			typ = t.X
+		case *ast.IndexExpr:
+			typ = t.X
+		case *ast.IndexListExpr:
+			typ = t.X
+		case *ast.SelectorExpr:
+			typ = t.Sel
+		case *ast.Ident:
+			return t.Name
+		default:
+			return ""
+		}
+	}
+}
+
+// RemoveUnexportedFields is a Pass that removes the unexported fields of the
+// struct types. Fields with a //debuggo:keep directive are kept.
+func RemoveUnexportedFields(file *File) {
+	for _, decl := range file.AST().Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			if SpecDirectives(genDecl, spec).Keep {
+				continue
+			}
+
+			structType, isStruct := spec.(*ast.TypeSpec).Type.(*ast.StructType)
+			if !isStruct {
+				continue
+			}
+
+			fields := structType.Fields.List[:0]
+			for _, field := range structType.Fields.List {
+				if parseDirectives(field.Doc).Keep || isExportedField(field) {
+					fields = append(fields, field)
+				}
+			}
+			structType.Fields.List = fields
+		}
+	}
+}
+
+func isExportedField(field *ast.Field) bool {
+	// Embedded field
+	if len(field.Names) == 0 {
+		return ast.IsExported(typeName(field.Type))
+	}
+
+	j := -1
+	for j != len(field.Names)-1 {
+		j++
+		if ast.IsExported(field.Names[j].Name) {
+			continue
+		}
+
+		field.Names = append(field.Names[:j], field.Names[j+1:]...)
+		j--
+	}
+
+	return len(field.Names) != 0
+}
@@ -0,0 +1,221 @@
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// runPasses parses the given source, runs the passes on it and returns the
+// printed file.
+func runPasses(t *testing.T, src string, passes ...Pass) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "file.go")
+	err := os.WriteFile(path, []byte(src), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := parseFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, pass := range passes {
+		pass(file)
+	}
+
+	buf := &bytes.Buffer{}
+	err = file.Fprint(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+func TestRemoveUnexportedDecls(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{
+			name: "generic receivers",
+			src: `package p
+
+type List[T any] struct{}
+
+func (l *List[T]) Len() int { return 0 }
+
+func (l *List[T]) at(i int) T { return *new(T) }
+
+type Pair[K comparable, V any] struct{}
+
+func (p Pair[K, V]) Key() K { return *new(K) }
+`,
+			expected: `package p
+
+type List[T any] struct{}
+
+func (l *List[T]) Len() int { return 0 }
+
+type Pair[K comparable, V any] struct{}
+
+func (p Pair[K, V]) Key() K { return *new(K) }
+`,
+		},
+		{
+			name: "value dependencies",
+			src: `package p
+
+type thing struct{}
+
+func (t *thing) name() string { return helper() }
+
+func newThing() *thing { return &thing{} }
+
+func helper() string { return "" }
+
+var Default = newThing()
+
+const Max = max + 1
+
+const max = 10
+`,
+			expected: `package p
+
+type thing struct{}
+
+func (t *thing) name() string { return helper() }
+
+func newThing() *thing { return &thing{} }
+
+var Default = newThing()
+
+const Max = max + 1
+
+const max = 10
+`,
+		},
+		{
+			name: "typed variables",
+			src: `package p
+
+import "errors"
+
+func newError() error { return errors.New("") }
+
+var ErrA error = newError()
+
+var ErrB error = errors.New("b")
+`,
+			expected: `package p
+
+import "errors"
+
+var ErrA error
+
+var ErrB error = errors.New("b")
+`,
+		},
+		{
+			name: "multiple names",
+			src: `package p
+
+func pair() (int, int) { return 1, 2 }
+
+var A, b = 1, 2
+
+var C, d = pair()
+
+var e, f = 1, 2
+`,
+			expected: `package p
+
+func pair() (int, int) { return 1, 2 }
+
+var A = 1
+
+var C, _ = pair()
+`,
+		},
+		{
+			name: "implicit constant values",
+			src: `package p
+
+type kind int
+
+const (
+	K0 kind = iota
+	k1
+	K2
+)
+
+const (
+	a = iota
+	b
+)
+`,
+			expected: `package p
+
+type kind int
+
+const (
+	K0 kind = iota
+	_
+	K2
+)
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := runPasses(t, test.src, RemoveUnexportedDecls)
+			if actual != test.expected {
+				t.Errorf("unexpected output:\n%v\nexpected:\n%v", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestRemoveUnexportedDeclsKeptBodies(t *testing.T) {
+	src := `package p
+
+// Always is available in every variant.
+//
+//debuggo:keep
+func Always() int { return helper() }
+
+func helper() int { return value + twice(1) }
+
+func twice(i int) int { return 2 * i }
+
+var value = 1
+
+func Stubbed() int { return unused() }
+
+func unused() int { return 0 }
+`
+	expected := `package p
+
+// Always is available in every variant.
+func Always() int { return helper() }
+
+func helper() int { return value + twice(1) }
+
+func twice(i int) int { return 2 * i }
+
+var value = 1
+
+func Stubbed() int { return 0 }
+`
+
+	actual := runPasses(t, src, RemoveUnexportedDecls, RemoveFuncBodies(nil), ReturnZeroValues)
+	if actual != expected {
+		t.Errorf("unexpected output:\n%v\nexpected:\n%v", actual, expected)
+	}
+}
@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+	passes map[string]Pass
+}{
+	passes: map[string]Pass{
+		"forward_variadics":        ForwardVariadics,
+		"remove_func_bodies":       RemoveFuncBodies(nil),
+		"remove_unexported_decls":  RemoveUnexportedDecls,
+		"remove_unexported_fields": RemoveUnexportedFields,
+		"remove_unused_imports":    RemoveUnusedImports,
+		"rename_func_params":       RenameFuncParams,
+		"return_zero_values":       ReturnZeroValues,
+	},
+}
+
+// RegisterPass registers a pass under the given name, so it can be referenced
+// by a configuration file. It replaces the pass previously registered under
+// the same name.
+func RegisterPass(name string, pass Pass) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	registry.passes[name] = pass
+}
+
+// LookupPass returns the pass registered under the given name.
+func LookupPass(name string) (Pass, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	pass, ok := registry.passes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown pass %q, registered passes are: %v", name, strings.Join(passNames(), ", "))
+	}
+
+	return pass, nil
+}
+
+// passNames returns the sorted names of the registered passes.
+func passNames() []string {
+	names := make([]string, 0, len(registry.passes))
+	for name := range registry.passes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,269 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ExpandTemplates returns a Pass that replaces the template declarations of the
+// file with a copy per level, in the order of the given levels. A template
+// declaration is a function or method with a //debuggo:template directive:
+//
+//	//debuggo:template=Level
+//	func Levelf(format string, args ...interface{}) {
+//		Level(fmt.Sprintf(format, args...))
+//	}
+//
+// The identifiers of a copy that start with the placeholder are renamed after
+// the level, Levelf becomes Auditf for the audit level, and the string literals
+// equal to the placeholder are replaced by the level name. Consecutive templates
+// are expanded together. A copy is skipped if the file already declares it, so
+// the file can specialize some levels.
+//
+// The value of a variable with a //debuggo:template directive is replaced by
+// the list of the levels, the file can map the levels to their position:
+//
+//	//debuggo:template=Level
+//	var levels = []string{"error", "info"}
+//
+// If the value is a composite literal whose only element refers to the
+// placeholder, the element is copied per level instead:
+//
+//	//debuggo:template=Level
+//	var enabled = map[string]func() bool{"Level": LevelEnabled}
+//
+// The first copy of each level is preceded by a "// Level level" section
+// comment.
+func ExpandTemplates(levels []string) Pass {
+	return func(file *File) {
+		astFile := file.AST()
+
+		for _, decl := range astFile.Decls {
+			if genDecl, isGenDecl := decl.(*ast.GenDecl); isGenDecl && genDecl.Tok == token.VAR {
+				expandTemplateVars(genDecl, levels)
+			}
+		}
+
+		declared := make(map[string]struct{})
+		for _, decl := range astFile.Decls {
+			if funcDecl, isFuncDecl := decl.(*ast.FuncDecl); isFuncDecl && templatePlaceholder(decl) == "" {
+				declared[funcKey(funcDecl)] = struct{}{}
+			}
+		}
+
+		decls := make([]ast.Decl, 0, len(astFile.Decls))
+		for i := 0; i < len(astFile.Decls); {
+			if templatePlaceholder(astFile.Decls[i]) == "" {
+				decls = append(decls, astFile.Decls[i])
+				i++
+				continue
+			}
+
+			j := i
+			for j < len(astFile.Decls) && templatePlaceholder(astFile.Decls[j]) != "" {
+				j++
+			}
+
+			for _, level := range levels {
+				first := true
+				for _, template := range astFile.Decls[i:j] {
+					funcDecl := expandTemplate(template.(*ast.FuncDecl), level)
+					if _, isDeclared := declared[funcKey(funcDecl)]; isDeclared {
+						continue
+					}
+
+					if first {
+						cmap := file.CommentMap()
+						cmap[funcDecl] = append(cmap[funcDecl], levelComment(template.(*ast.FuncDecl), level))
+						first = false
+					}
+					decls = append(decls, funcDecl)
+				}
+			}
+			i = j
+		}
+
+		astFile.Decls = decls
+	}
+}
+
+// templatePlaceholder returns the placeholder of the given template function,
+// an empty string if it's not a template.
+func templatePlaceholder(decl ast.Decl) string {
+	funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+	if !isFuncDecl {
+		return ""
+	}
+
+	return FuncDirectives(funcDecl).Template
+}
+
+// expandTemplate returns a copy of the given template for the given level.
+func expandTemplate(template *ast.FuncDecl, level string) *ast.FuncDecl {
+	funcDecl := expandNode(template, templatePlaceholder(template), level).(*ast.FuncDecl)
+	funcDecl.Doc = nil
+
+	return funcDecl
+}
+
+// expandNode returns a copy of the given node for the given level: the
+// identifiers starting with the placeholder are renamed after the level and
+// the string literals equal to the placeholder are replaced by the level name.
+func expandNode(node ast.Node, placeholder, level string) ast.Node {
+	name := strings.ToUpper(level[:1]) + level[1:]
+
+	result := copyNode(node)
+	ast.Inspect(result, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.Ident:
+			if strings.HasPrefix(n.Name, placeholder) {
+				n.Name = name + strings.TrimPrefix(n.Name, placeholder)
+			}
+
+		case *ast.BasicLit:
+			if n.Kind == token.STRING && n.Value == strconv.Quote(placeholder) {
+				n.Value = strconv.Quote(level)
+			}
+		}
+
+		return true
+	})
+
+	return result
+}
+
+// expandTemplateVars replaces the values of the template variables of the
+// given declaration, see ExpandTemplates.
+func expandTemplateVars(genDecl *ast.GenDecl, levels []string) {
+	for _, spec := range genDecl.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		placeholder := SpecDirectives(genDecl, spec).Template
+		if placeholder == "" || len(valueSpec.Names) != 1 {
+			continue
+		}
+
+		if lit, isLit := singleValue(valueSpec).(*ast.CompositeLit); isLit && len(lit.Elts) == 1 &&
+			refersTo(lit.Elts[0], placeholder) {
+			elt := lit.Elts[0]
+			lit.Elts = make([]ast.Expr, len(levels))
+			for i, level := range levels {
+				lit.Elts[i] = expandNode(elt, placeholder, level).(ast.Expr)
+			}
+			continue
+		}
+
+		list := &ast.CompositeLit{
+			Type: &ast.ArrayType{Elt: ast.NewIdent("string")},
+		}
+		for _, level := range levels {
+			list.Elts = append(list.Elts, &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(level)})
+		}
+
+		valueSpec.Type = nil
+		valueSpec.Values = []ast.Expr{list}
+	}
+}
+
+// singleValue returns the value of the given spec, nil if it hasn't exactly
+// one value.
+func singleValue(valueSpec *ast.ValueSpec) ast.Expr {
+	if len(valueSpec.Values) != 1 {
+		return nil
+	}
+
+	return valueSpec.Values[0]
+}
+
+// refersTo reports whether the given node contains an identifier starting
+// with the placeholder or a string literal equal to it.
+func refersTo(node ast.Node, placeholder string) bool {
+	found := false
+	ast.Inspect(node, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.Ident:
+			found = found || strings.HasPrefix(n.Name, placeholder)
+		case *ast.BasicLit:
+			found = found || (n.Kind == token.STRING && n.Value == strconv.Quote(placeholder))
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// levelComment returns the section comment preceding the first copy of a
+// level, in place of the directive of the template.
+func levelComment(template *ast.FuncDecl, level string) *ast.CommentGroup {
+	return &ast.CommentGroup{
+		List: []*ast.Comment{{
+			Slash: template.Doc.Pos(),
+			Text:  "// " + strings.ToUpper(level[:1]) + level[1:] + " level",
+		}},
+	}
+}
+
+// funcKey identifies a function or method of a file.
+func funcKey(funcDecl *ast.FuncDecl) string {
+	if recv := recvTypeName(funcDecl); recv != "" {
+		return recv + "." + funcDecl.Name.Name
+	}
+
+	return funcDecl.Name.Name
+}
+
+var (
+	objectType = reflect.TypeOf((*ast.Object)(nil))
+	scopeType  = reflect.TypeOf((*ast.Scope)(nil))
+)
+
+// copyNode returns a deep copy of the given node. Objects and scopes are
+// shared with the original node, the copy resolves to the same declarations.
+func copyNode(node ast.Node) ast.Node {
+	return copyValue(reflect.ValueOf(node)).Interface().(ast.Node)
+}
+
+func copyValue(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() || value.Type() == objectType || value.Type() == scopeType {
+			return value
+		}
+
+		result := reflect.New(value.Type().Elem())
+		result.Elem().Set(copyValue(value.Elem()))
+		return result
+
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.New(value.Type()).Elem()
+		result.Set(copyValue(value.Elem()))
+		return result
+
+	case reflect.Struct:
+		result := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.NumField(); i++ {
+			result.Field(i).Set(copyValue(value.Field(i)))
+		}
+		return result
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(copyValue(value.Index(i)))
+		}
+		return result
+	}
+
+	return value
+}
@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"go/build/constraint"
+)
+
+// Variant define a conditionally compiled version of the source files.
+type Variant struct {
+	// Suffix is inserted before the extension of the generated file names.
+	Suffix string
+	// Constraint is the build constraint of the generated files. The variant
+	// without a constraint is the default one, it is selected when no other
+	// variant is.
+	Constraint constraint.Expr
+	// Passes edit the source files, they're applied in order.
+	Passes []Pass
+}
+
+// Debug returns a variant that keeps the source files as is, except for the
+// variadic arguments that are forwarded (see ForwardVariadics). The variant is
+// enabled if any of the given tags is set.
+func Debug(tags ...string) *Variant {
+	return &Variant{
+		Suffix:     "",
+		Constraint: Or(tagExprs(tags)...),
+		Passes: []Pass{
+			ForwardVariadics,
+		},
+	}
+}
+
+// Prod returns a variant that stubs the exported API of the source files. It's
+// the default variant, enabled if no other variant is.
+func Prod() *Variant {
+	return &Variant{
+		Suffix: ".prod",
+		Passes: []Pass{
+			RemoveUnexportedDecls,
+			RemoveUnexportedFields,
+			RenameFuncParams,
+			RemoveFuncBodies(nil),
+			ReturnZeroValues,
+			RemoveUnusedImports,
+		},
+	}
+}
+
+func tagExprs(tags []string) []constraint.Expr {
+	exprs := make([]constraint.Expr, len(tags))
+	for i, tag := range tags {
+		exprs[i] = Tag(tag)
+	}
+
+	return exprs
+}
@@ -0,0 +1,47 @@
+package overlay
+
+import (
+	"fmt"
+)
+
+// Option configure a Generator.
+type Option func(*Generator) error
+
+// Dir sets the root directory of the module to strip, it defaults to the
+// current directory.
+func Dir(dir string) Option {
+	return func(g *Generator) error {
+		if dir == "" {
+			return fmt.Errorf("the module directory is empty")
+		}
+
+		g.dir = dir
+		return nil
+	}
+}
+
+// OutputDir sets the directory of the stripped copies and of the overlay file,
+// it defaults to ".debuggo".
+func OutputDir(dir string) Option {
+	return func(g *Generator) error {
+		if dir == "" {
+			return fmt.Errorf("the output directory is empty")
+		}
+
+		g.outputDir = dir
+		return nil
+	}
+}
+
+// Tags sets the build tags of the build using the overlay. Calls enabled by
+// the tags are kept (e.g. the "debuggo_log_info" tag keeps the log calls up to
+// the info level).
+func Tags(tags ...string) Option {
+	return func(g *Generator) error {
+		for _, tag := range tags {
+			g.tags[tag] = struct{}{}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,328 @@
+// Package overlay strips the calls to the debuggo packages from the source
+// files of a module.
+//
+// The go command evaluates the arguments of the calls to the empty stubs of
+// the production variants. The stripped copies of the source files are used
+// instead of the originals with "go build -overlay", so the arguments are never
+// evaluated and the source tree is left untouched.
+package overlay
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/negrel/debuggo/internal/analysis/debuggo"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	// AssertPkg is the import path of the assert package.
+	AssertPkg = "github.com/negrel/debuggo/pkg/assert"
+	// LogPkg is the import path of the log package.
+	LogPkg = "github.com/negrel/debuggo/pkg/log"
+)
+
+// Overlay is the content of the overlay file given to "go build -overlay".
+type Overlay struct {
+	// Replace maps the path of the source files to the path of their stripped
+	// copies.
+	Replace map[string]string
+}
+
+// Generator writes the stripped copies of the source files of a module and
+// the overlay file that replaces them.
+type Generator struct {
+	dir       string
+	outputDir string
+	tags      map[string]struct{}
+	variants  variants
+}
+
+// New returns a new Generator configured with the given options.
+func New(options ...Option) (*Generator, error) {
+	gen := &Generator{
+		dir:       ".",
+		outputDir: ".debuggo",
+		tags:      make(map[string]struct{}),
+	}
+
+	for _, option := range options {
+		if err := option(gen); err != nil {
+			return nil, err
+		}
+	}
+
+	return gen, nil
+}
+
+// Start strips the source files of the module and writes the overlay file
+// into the output directory. It returns the path of the overlay file.
+func (g *Generator) Start() (string, error) {
+	dir, err := filepath.Abs(g.dir)
+	if err != nil {
+		return "", err
+	}
+
+	outputDir, err := filepath.Abs(g.outputDir)
+	if err != nil {
+		return "", err
+	}
+
+	tags := make([]string, 0, len(g.tags))
+	for tag := range g.tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	g.variants, err = selectVariants(dir, tags)
+	if err != nil {
+		return "", err
+	}
+
+	pkgs, err := load(dir, tags)
+	if err != nil {
+		return "", err
+	}
+
+	filters := map[string]func(fn *types.Func) bool{
+		AssertPkg: g.assertFilter(),
+		LogPkg:    g.logFilter(),
+	}
+
+	overlay := Overlay{Replace: make(map[string]string)}
+	for _, pkg := range pkgs {
+		if isDebuggoPkg(pkg.PkgPath) {
+			continue
+		}
+
+		for _, file := range pkg.Syntax {
+			path := pkg.Fset.File(file.Pos()).Name()
+			if _, isDone := overlay.Replace[path]; isDone || !isWithin(dir, path) || isWithin(outputDir, path) {
+				continue
+			}
+
+			stmts := strippedStmts(file, pkg.TypesInfo, filters)
+			if len(stmts) == 0 {
+				continue
+			}
+
+			src, err := ioutil.ReadFile(path)
+			if err != nil {
+				return "", err
+			}
+
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return "", err
+			}
+
+			dst := filepath.Join(outputDir, rel)
+			err = os.MkdirAll(filepath.Dir(dst), 0755)
+			if err != nil {
+				return "", err
+			}
+
+			err = ioutil.WriteFile(dst, disable(src, pkg.Fset, stmts), 0755)
+			if err != nil {
+				return "", err
+			}
+
+			overlay.Replace[path] = dst
+		}
+	}
+
+	overlayFile := filepath.Join(outputDir, "overlay.json")
+	return overlayFile, writeOverlay(overlayFile, overlay)
+}
+
+// load loads the packages of the module in the given directory, with their
+// tests, as built with the given tags.
+func load(dir string, tags []string) ([]*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode:  packages.LoadAllSyntax,
+		Dir:   dir,
+		Tests: true,
+	}
+	if len(tags) != 0 {
+		cfg.BuildFlags = []string{"-tags", strings.Join(tags, ",")}
+	}
+
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []string
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("can't load the packages:\n%v", strings.Join(errs, "\n"))
+	}
+
+	return pkgs, nil
+}
+
+// isDebuggoPkg reports whether the package with the given path is the assert
+// or log package, or one of their sub packages. Their own calls are kept.
+func isDebuggoPkg(path string) bool {
+	for _, pkg := range []string{AssertPkg, LogPkg} {
+		if path == pkg || strings.HasPrefix(path, pkg+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isWithin reports whether path is in the given directory.
+func isWithin(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// assertFilter returns the function filter of the assert package: every call
+// is stripped unless assertions are enabled. A filter reports whether the calls
+// to the given function or method are stripped.
+func (g *Generator) assertFilter() func(fn *types.Func) bool {
+	if g.variants.assert {
+		return nil
+	}
+
+	return func(fn *types.Func) bool {
+		return true
+	}
+}
+
+// logFilter returns the function filter of the log package: the calls to the
+// log functions and methods of the levels disabled by the tags are stripped.
+// The levels of the functions are resolved as by the analyzers of debuggo vet.
+func (g *Generator) logFilter() func(fn *types.Func) bool {
+	levels, enabled := g.variants.logLevels, g.variants.logLevel
+	if enabled == len(levels)-1 {
+		return nil
+	}
+
+	return func(fn *types.Func) bool {
+		level := debuggo.LogLevel(fn)
+		for i, l := range levels {
+			if l == level {
+				return i > enabled
+			}
+		}
+
+		return false
+	}
+}
+
+// strippedStmts returns the expression, defer and go statements of the file
+// that call a stripped function. Simple statements of if, for and switch
+// clauses can't be wrapped in a block, they're never stripped. A deferred call
+// wrapped in a block still runs when the function returns.
+func strippedStmts(file *ast.File, info *types.Info, filters map[string]func(fn *types.Func) bool) []ast.Stmt {
+	stmts := make([]ast.Stmt, 0)
+	clauses := make(map[ast.Stmt]struct{})
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.IfStmt:
+			clauses[n.Init] = struct{}{}
+		case *ast.ForStmt:
+			clauses[n.Init] = struct{}{}
+			clauses[n.Post] = struct{}{}
+		case *ast.SwitchStmt:
+			clauses[n.Init] = struct{}{}
+		case *ast.TypeSwitchStmt:
+			clauses[n.Init] = struct{}{}
+		case *ast.ExprStmt:
+			if _, isClause := clauses[n]; !isClause && isStrippedCall(info, n.X, filters) {
+				stmts = append(stmts, n)
+				// Nested statements are stripped with the call.
+				return false
+			}
+		case *ast.DeferStmt:
+			if isStrippedCall(info, n.Call, filters) {
+				stmts = append(stmts, n)
+				return false
+			}
+		case *ast.GoStmt:
+			if isStrippedCall(info, n.Call, filters) {
+				stmts = append(stmts, n)
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return stmts
+}
+
+// isStrippedCall reports whether expr is a call to a stripped function or
+// method of the debuggo packages.
+func isStrippedCall(info *types.Info, expr ast.Expr, filters map[string]func(fn *types.Func) bool) bool {
+	callExpr, isCallExpr := expr.(*ast.CallExpr)
+	if !isCallExpr {
+		return false
+	}
+
+	fn, isFunc := typeutil.Callee(info, callExpr).(*types.Func)
+	if !isFunc || fn.Pkg() == nil || !fn.Exported() {
+		return false
+	}
+
+	filter := filters[fn.Pkg().Path()]
+
+	return filter != nil && filter(fn)
+}
+
+// disable wraps the given statements in a "if false { ... }" block. The
+// compiler drops the block, so the arguments are never evaluated, but the
+// variables and imports used by the calls remain used. Statements are edited
+// in place to preserve the line numbers of the file.
+func disable(src []byte, fset *token.FileSet, stmts []ast.Stmt) []byte {
+	sort.Slice(stmts, func(i, j int) bool {
+		return stmts[i].Pos() < stmts[j].Pos()
+	})
+
+	result := make([]byte, 0, len(src)+len(stmts)*len("if false {  }"))
+	offset := 0
+	for _, stmt := range stmts {
+		begin := fset.Position(stmt.Pos()).Offset
+		end := fset.Position(stmt.End()).Offset
+
+		result = append(result, src[offset:begin]...)
+		result = append(result, "if false { "...)
+		result = append(result, src[begin:end]...)
+		result = append(result, " }"...)
+		offset = end
+	}
+	result = append(result, src[offset:]...)
+
+	return result
+}
+
+func writeOverlay(path string, overlay Overlay) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(overlay, "", "\t")
+	if err != nil {
+		return fmt.Errorf("invalid overlay: %v", err)
+	}
+
+	return ioutil.WriteFile(path, content, 0755)
+}
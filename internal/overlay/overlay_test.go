@@ -0,0 +1,74 @@
+package overlay
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files instead of comparing them.")
+
+func TestStart(t *testing.T) {
+	for _, test := range []struct {
+		tags   []string
+		golden string
+	}{
+		{nil, "a.go.golden"},
+		{[]string{"info"}, "a.go.info.golden"},
+	} {
+		outputDir := t.TempDir()
+		gen, err := New(Dir("testdata/a"), OutputDir(outputDir), Tags(test.tags...))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		overlayFile, err := gen.Start()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		content, err := ioutil.ReadFile(overlayFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var overlay Overlay
+		if err := json.Unmarshal(content, &overlay); err != nil {
+			t.Fatal(err)
+		}
+
+		src, err := filepath.Abs("testdata/a/a.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(overlay.Replace) != 1 || overlay.Replace[src] == "" {
+			t.Fatalf("tags %v: overlay replaces %v, want %v only", test.tags, overlay.Replace, src)
+		}
+
+		got, err := ioutil.ReadFile(overlay.Replace[src])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		golden := filepath.Join("testdata", test.golden)
+		if *update {
+			if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		want, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("tags %v: stripped file:\n%s\nwant:\n%s", test.tags, got, want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package a
+
+import (
+	"github.com/negrel/debuggo/pkg/assert"
+	"github.com/negrel/debuggo/pkg/log"
+)
+
+func expensive() int { return 0 }
+
+func calls(logger *log.Logger, values []int) {
+	assert.Equal(len(values), 1)
+	assert.New().Equal(len(values), 1)
+
+	log.Debug("value", expensive())
+	logger.Debug("value", expensive())
+	logger.Named("a").Debugf("%v", expensive())
+	log.Info("value", expensive())
+	logger.Info("value", expensive())
+	logger.SetPrefix("a: ")
+
+	defer log.Debug("deferred", expensive())
+	defer logger.Info("deferred", expensive())
+	go log.Debugf("%v", expensive())
+
+	if assert.True(len(values) != 0) {
+		return
+	}
+}
@@ -0,0 +1,141 @@
+package overlay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// variants are the variants of the debuggo packages selected by the build
+// tags.
+type variants struct {
+	assert bool
+	// logLevels are the levels of the log package, from the least to the most
+	// verbose.
+	logLevels []string
+	// logLevel is the index of the selected level in logLevels, -1 if the logs
+	// are disabled.
+	logLevel int
+}
+
+// selectVariants lists the files of the debuggo packages selected by the
+// build tags using the go command. The aliases (e.g. "info" for
+// "debuggo_log_info") and the profiles of the tags are resolved by the build
+// constraints of the packages, as in the build. Packages that aren't
+// dependencies of the module are disabled.
+func selectVariants(dir string, tags []string) (variants, error) {
+	args := []string{"list", "-e", "-json=ImportPath,Dir,GoFiles"}
+	if len(tags) != 0 {
+		args = append(args, "-tags", strings.Join(tags, ","))
+	}
+	args = append(args, AssertPkg, LogPkg)
+
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return variants{}, fmt.Errorf("go list: %v: %v", err, stderr)
+	}
+
+	selected := variants{logLevel: -1}
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	for {
+		var pkg struct {
+			ImportPath string
+			Dir        string
+			GoFiles    []string
+		}
+		err := decoder.Decode(&pkg)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return variants{}, fmt.Errorf("go list: %v", err)
+		}
+
+		switch pkg.ImportPath {
+		case AssertPkg:
+			for _, file := range pkg.GoFiles {
+				selected.assert = selected.assert || file == "assertions.go"
+			}
+
+		case LogPkg:
+			selected.logLevels, err = logLevels(pkg.Dir, pkg.GoFiles)
+			if err != nil {
+				return variants{}, err
+			}
+
+			for _, file := range pkg.GoFiles {
+				for i, level := range selected.logLevels {
+					if file == "exported."+level+".go" {
+						selected.logLevel = i
+					}
+				}
+			}
+		}
+	}
+
+	return selected, nil
+}
+
+// logLevels returns the levels of the log package, the value of the levels
+// variable generated in the given files.
+func logLevels(dir string, files []string) ([]string, error) {
+	fset := token.NewFileSet()
+	for _, name := range files {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, isGenDecl := decl.(*ast.GenDecl)
+			if !isGenDecl || genDecl.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				if len(valueSpec.Names) == 1 && valueSpec.Names[0].Name == "levels" && len(valueSpec.Values) == 1 {
+					return stringList(valueSpec.Values[0])
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// stringList returns the elements of the given string slice literal.
+func stringList(expr ast.Expr) ([]string, error) {
+	lit, isLit := expr.(*ast.CompositeLit)
+	if !isLit {
+		return nil, fmt.Errorf("%v: levels isn't a list literal", LogPkg)
+	}
+
+	result := make([]string, 0, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		basicLit, isBasicLit := elt.(*ast.BasicLit)
+		if !isBasicLit || basicLit.Kind != token.STRING {
+			return nil, fmt.Errorf("%v: levels isn't a list of strings", LogPkg)
+		}
+
+		value, err := strconv.Unquote(basicLit.Value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+
+	return result, nil
+}
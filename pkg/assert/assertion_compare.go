@@ -1,4 +1,5 @@
-// +build assert
+//go:build debuggo_assert || assert || debuggo_dev || debuggo_staging
+// +build debuggo_assert assert debuggo_dev debuggo_staging
 
 package assert
 
@@ -308,57 +309,60 @@ func compare(obj1, obj2 interface{}, kind reflect.Kind) (CompareType, bool) {
 
 // Greater asserts that the first element is greater than the second
 //
-//    assert.Greater(t, 2, 1)
-//    assert.Greater(t, float64(2), float64(1))
-//    assert.Greater(t, "b", "a")
-func Greater(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Greater(e1, e2, msgAndArgs)
+//	assert.Greater(t, 2, 1)
+//	assert.Greater(t, float64(2), float64(1))
+//	assert.Greater(t, "b", "a")
+func Greater(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Greater(std.newCall("Greater", nil, nil), e1, e2, msgAndArgs...)
 }
 
 // GreaterOrEqual asserts that the first element is greater than or equal to the second
 //
-//    assert.GreaterOrEqual(t, 2, 1)
-//    assert.GreaterOrEqual(t, 2, 2)
-//    assert.GreaterOrEqual(t, "b", "a")
-//    assert.GreaterOrEqual(t, "b", "b")
-func GreaterOrEqual(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_GreaterOrEqual(e1, e2, msgAndArgs)
+//	assert.GreaterOrEqual(t, 2, 1)
+//	assert.GreaterOrEqual(t, 2, 2)
+//	assert.GreaterOrEqual(t, "b", "a")
+//	assert.GreaterOrEqual(t, "b", "b")
+func GreaterOrEqual(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_GreaterOrEqual(std.newCall("GreaterOrEqual", nil, nil), e1, e2, msgAndArgs...)
 }
 
 // Less asserts that the first element is less than the second
 //
-//    assert.Less(t, 1, 2)
-//    assert.Less(t, float64(1), float64(2))
-//    assert.Less(t, "a", "b")
-func Less(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Less(e1, e2, msgAndArgs)
+//	assert.Less(t, 1, 2)
+//	assert.Less(t, float64(1), float64(2))
+//	assert.Less(t, "a", "b")
+func Less(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Less(std.newCall("Less", nil, nil), e1, e2, msgAndArgs...)
 }
 
 // LessOrEqual asserts that the first element is less than or equal to the second
 //
-//    assert.LessOrEqual(t, 1, 2)
-//    assert.LessOrEqual(t, 2, 2)
-//    assert.LessOrEqual(t, "a", "b")
-//    assert.LessOrEqual(t, "b", "b")
-func LessOrEqual(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_LessOrEqual(e1, e2, msgAndArgs)
+//	assert.LessOrEqual(t, 1, 2)
+//	assert.LessOrEqual(t, 2, 2)
+//	assert.LessOrEqual(t, "a", "b")
+//	assert.LessOrEqual(t, "b", "b")
+func LessOrEqual(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_LessOrEqual(std.newCall("LessOrEqual", nil, nil), e1, e2, msgAndArgs...)
 }
 
-func compareTwoValues(e1 interface{}, e2 interface{}, allowedComparesResults []CompareType, failMessage string, msgAndArgs ...interface{}) bool {
+func compareTwoValues(t TestingT, e1 interface{}, e2 interface{}, allowedComparesResults []CompareType, failMessage string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	e1Kind := reflect.ValueOf(e1).Kind()
 	e2Kind := reflect.ValueOf(e2).Kind()
 	if e1Kind != e2Kind {
-		return debuggoGen_Fail("Elements should be the same type", msgAndArgs...)
+		return debuggoGen_Fail(t, "Elements should be the same type", msgAndArgs...)
 	}
 
 	compareResult, isComparable := compare(e1, e2, e1Kind)
 	if !isComparable {
-		return debuggoGen_Fail(fmt.Sprintf("Can not compare type \"%s\"", reflect.TypeOf(e1)), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Can not compare type \"%s\"", reflect.TypeOf(e1)), msgAndArgs...)
 	}
 
 	if !containsValue(allowedComparesResults, compareResult) {
-		return debuggoGen_Fail(fmt.Sprintf(failMessage, e1, e2), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf(failMessage, e1, e2), msgAndArgs...)
 	}
 
 	return true
@@ -374,18 +378,18 @@ func containsValue(values []CompareType, value CompareType) bool {
 	return false
 }
 
-func debuggoGen_Greater(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
-	return compareTwoValues(e1, e2, []CompareType{compareGreater}, "\"%v\" is not greater than \"%v\"", msgAndArgs)
+func debuggoGen_Greater(t TestingT, e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return compareTwoValues(t, e1, e2, []CompareType{compareGreater}, "\"%v\" is not greater than \"%v\"", msgAndArgs...)
 }
 
-func debuggoGen_GreaterOrEqual(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
-	return compareTwoValues(e1, e2, []CompareType{compareGreater, compareEqual}, "\"%v\" is not greater than or equal to \"%v\"", msgAndArgs)
+func debuggoGen_GreaterOrEqual(t TestingT, e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return compareTwoValues(t, e1, e2, []CompareType{compareGreater, compareEqual}, "\"%v\" is not greater than or equal to \"%v\"", msgAndArgs...)
 }
 
-func debuggoGen_Less(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
-	return compareTwoValues(e1, e2, []CompareType{compareLess}, "\"%v\" is not less than \"%v\"", msgAndArgs)
+func debuggoGen_Less(t TestingT, e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return compareTwoValues(t, e1, e2, []CompareType{compareLess}, "\"%v\" is not less than \"%v\"", msgAndArgs...)
 }
 
-func debuggoGen_LessOrEqual(e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
-	return compareTwoValues(e1, e2, []CompareType{compareLess, compareEqual}, "\"%v\" is not less than or equal to \"%v\"", msgAndArgs)
+func debuggoGen_LessOrEqual(t TestingT, e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return compareTwoValues(t, e1, e2, []CompareType{compareLess, compareEqual}, "\"%v\" is not less than or equal to \"%v\"", msgAndArgs...)
 }
@@ -1,4 +1,5 @@
-// +build assert
+//go:build debuggo_assert || assert || debuggo_dev || debuggo_staging
+// +build debuggo_assert assert debuggo_dev debuggo_staging
 
 package assert
 
@@ -8,7 +9,7 @@ import (
 )
 
 // isOrdered checks that collection contains orderable elements.
-func isOrdered(object interface{}, allowedComparesResults []CompareType, failMessage string, msgAndArgs ...interface{}) bool {
+func isOrdered(t TestingT, object interface{}, allowedComparesResults []CompareType, failMessage string, msgAndArgs ...interface{}) bool {
 	objKind := reflect.TypeOf(object).Kind()
 	if objKind != reflect.Slice && objKind != reflect.Array {
 		return false
@@ -35,11 +36,11 @@ func isOrdered(object interface{}, allowedComparesResults []CompareType, failMes
 		compareResult, isComparable := compare(prevValueInterface, valueInterface, firstValueKind)
 
 		if !isComparable {
-			return debuggoGen_Fail(fmt.Sprintf("Can not compare type \"%s\" and \"%s\"", reflect.TypeOf(value), reflect.TypeOf(prevValue)), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf("Can not compare type \"%s\" and \"%s\"", reflect.TypeOf(value), reflect.TypeOf(prevValue)), msgAndArgs...)
 		}
 
 		if !containsValue(allowedComparesResults, compareResult) {
-			return debuggoGen_Fail(fmt.Sprintf(failMessage, prevValue, value), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf(failMessage, prevValue, value), msgAndArgs...)
 		}
 	}
 
@@ -48,52 +49,52 @@ func isOrdered(object interface{}, allowedComparesResults []CompareType, failMes
 
 // IsIncreasing asserts that the collection is increasing
 //
-//    assert.IsIncreasing(t, []int{1, 2, 3})
-//    assert.IsIncreasing(t, []float{1, 2})
-//    assert.IsIncreasing(t, []string{"a", "b"})
-func IsIncreasing(object interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_IsIncreasing(object, msgAndArgs)
+//	assert.IsIncreasing(t, []int{1, 2, 3})
+//	assert.IsIncreasing(t, []float{1, 2})
+//	assert.IsIncreasing(t, []string{"a", "b"})
+func IsIncreasing(object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_IsIncreasing(std.newCall("IsIncreasing", nil, nil), object, msgAndArgs...)
 }
 
 // IsNonIncreasing asserts that the collection is not increasing
 //
-//    assert.IsNonIncreasing(t, []int{2, 1, 1})
-//    assert.IsNonIncreasing(t, []float{2, 1})
-//    assert.IsNonIncreasing(t, []string{"b", "a"})
-func IsNonIncreasing(object interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_IsNonIncreasing(object, msgAndArgs)
+//	assert.IsNonIncreasing(t, []int{2, 1, 1})
+//	assert.IsNonIncreasing(t, []float{2, 1})
+//	assert.IsNonIncreasing(t, []string{"b", "a"})
+func IsNonIncreasing(object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_IsNonIncreasing(std.newCall("IsNonIncreasing", nil, nil), object, msgAndArgs...)
 }
 
 // IsDecreasing asserts that the collection is decreasing
 //
-//    assert.IsDecreasing(t, []int{2, 1, 0})
-//    assert.IsDecreasing(t, []float{2, 1})
-//    assert.IsDecreasing(t, []string{"b", "a"})
-func IsDecreasing(object interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_IsDecreasing(object, msgAndArgs)
+//	assert.IsDecreasing(t, []int{2, 1, 0})
+//	assert.IsDecreasing(t, []float{2, 1})
+//	assert.IsDecreasing(t, []string{"b", "a"})
+func IsDecreasing(object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_IsDecreasing(std.newCall("IsDecreasing", nil, nil), object, msgAndArgs...)
 }
 
 // IsNonDecreasing asserts that the collection is not decreasing
 //
-//    assert.IsNonDecreasing(t, []int{1, 1, 2})
-//    assert.IsNonDecreasing(t, []float{1, 2})
-//    assert.IsNonDecreasing(t, []string{"a", "b"})
-func IsNonDecreasing(object interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_IsNonDecreasing(object, msgAndArgs)
+//	assert.IsNonDecreasing(t, []int{1, 1, 2})
+//	assert.IsNonDecreasing(t, []float{1, 2})
+//	assert.IsNonDecreasing(t, []string{"a", "b"})
+func IsNonDecreasing(object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_IsNonDecreasing(std.newCall("IsNonDecreasing", nil, nil), object, msgAndArgs...)
 }
 
-func debuggoGen_IsIncreasing(object interface{}, msgAndArgs ...interface{}) bool {
-	return isOrdered(object, []CompareType{compareLess}, "\"%v\" is not less than \"%v\"", msgAndArgs)
+func debuggoGen_IsIncreasing(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	return isOrdered(t, object, []CompareType{compareLess}, "\"%v\" is not less than \"%v\"", msgAndArgs...)
 }
 
-func debuggoGen_IsNonIncreasing(object interface{}, msgAndArgs ...interface{}) bool {
-	return isOrdered(object, []CompareType{compareEqual, compareGreater}, "\"%v\" is not greater than or equal to \"%v\"", msgAndArgs)
+func debuggoGen_IsNonIncreasing(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	return isOrdered(t, object, []CompareType{compareEqual, compareGreater}, "\"%v\" is not greater than or equal to \"%v\"", msgAndArgs...)
 }
 
-func debuggoGen_IsDecreasing(object interface{}, msgAndArgs ...interface{}) bool {
-	return isOrdered(object, []CompareType{compareGreater}, "\"%v\" is not greater than \"%v\"", msgAndArgs)
+func debuggoGen_IsDecreasing(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	return isOrdered(t, object, []CompareType{compareGreater}, "\"%v\" is not greater than \"%v\"", msgAndArgs...)
 }
 
-func debuggoGen_IsNonDecreasing(object interface{}, msgAndArgs ...interface{}) bool {
-	return isOrdered(object, []CompareType{compareLess, compareEqual}, "\"%v\" is not less than or equal to \"%v\"", msgAndArgs)
+func debuggoGen_IsNonDecreasing(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	return isOrdered(t, object, []CompareType{compareLess, compareEqual}, "\"%v\" is not less than or equal to \"%v\"", msgAndArgs...)
 }
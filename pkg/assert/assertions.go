@@ -1,4 +1,5 @@
-// +build assert
+//go:build debuggo_assert || assert || debuggo_dev || debuggo_staging
+// +build debuggo_assert assert debuggo_dev debuggo_staging
 
 package assert
 
@@ -24,8 +25,6 @@ import (
 	yaml "gopkg.in/yaml.v3"
 )
 
-//go:generate sh -c "cd ../_codegen && go build && cd - && ../_codegen/_codegen -output-package=assert -template=assertion_format.go.tmpl"
-
 // TestingT is an interface wrapper around *testing.T
 type TestingT interface {
 	Errorf(format string, args ...interface{})
@@ -50,44 +49,23 @@ type ErrorAssertionFunc func(TestingT, error, ...interface{}) bool
 // Comparison is a custom function that returns true on success and false on failure
 type Comparison func() (success bool)
 
-/*
-	Helper functions
-*/
-
 // ObjectsAreEqual determines if two objects are considered equal.
 //
 // This function does no assertion of any kind.
-func ObjectsAreEqual(expected, actual interface{}) { debuggoGen_ObjectsAreEqual(expected, actual) }
+func ObjectsAreEqual(expected, actual interface{}) bool {
+	return debuggoGen_ObjectsAreEqual(expected, actual)
+}
 
 // ObjectsAreEqualValues gets whether two objects are equal, or if their
 // values are equal.
-func ObjectsAreEqualValues(expected, actual interface{}) {
-	debuggoGen_ObjectsAreEqualValues(expected, actual)
+func ObjectsAreEqualValues(expected, actual interface{}) bool {
+	return debuggoGen_ObjectsAreEqualValues(expected, actual)
 }
 
-// Attempt comparison after type conversion
-
-/* CallerInfo is necessary because the assert functions use the testing object
-internally, causing it to print the file:line of the assert method, rather than where
-the problem actually occurred in calling code.*/
-
 // CallerInfo returns an array of strings containing the file and line number
 // of each stack frame leading from the current test to the assert call that
 // failed.
-func CallerInfo() { debuggoGen_CallerInfo() }
-
-// The breaks below failed to terminate the loop, and we ran off the
-// end of the call stack.
-
-// This is a huge edge case, but it will panic if this is the case, see #180
-
-// testing.tRunner is the standard library function that calls
-// tests. Subtests are called directly by tRunner, without going through
-// the Test/Benchmark/Example function that contains the t.Run calls, so
-// with subtests we should break when we hit tRunner, without adding it
-// to the list of callers.
-
-// Drop the package
+func CallerInfo() []string { return debuggoGen_CallerInfo() }
 
 // Stolen from the `go test` tool.
 // isTest tells whether name looks like a test (or benchmark, according to prefix).
@@ -145,23 +123,15 @@ type failNower interface {
 }
 
 // FailNow fails test
-func FailNow(failureMessage string, msgAndArgs ...interface{}) {
-	debuggoGen_FailNow(failureMessage, msgAndArgs)
+func FailNow(failureMessage string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_FailNow(std.newCall("FailNow", nil, nil), failureMessage, msgAndArgs...)
 }
 
-// We cannot extend TestingT with FailNow() and
-// maintain backwards compatibility, so we fallback
-// to panicking when FailNow is not available in
-// TestingT.
-// See issue #263
-
 // Fail reports a failure through
-func Fail(failureMessage string, msgAndArgs ...interface{}) {
-	debuggoGen_Fail(failureMessage, msgAndArgs)
+func Fail(failureMessage string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Fail(std.newCall("Fail", nil, nil), failureMessage, msgAndArgs...)
 }
 
-// Add test name if the Go version supports it
-
 type labeledContent struct {
 	label   string
 	content string
@@ -169,7 +139,7 @@ type labeledContent struct {
 
 // labeledOutput returns a string consisting of the provided labeledContent. Each labeled output is appended in the following manner:
 //
-//   \t{{label}}:{{align_spaces}}\t{{content}}\n
+//	\t{{label}}:{{align_spaces}}\t{{content}}\n
 //
 // The initial carriage return is required to undo/erase any padding added by testing.T.Errorf. The "\t{{label}}:" is for the label.
 // If a label is shorter than the longest label provided, padding spaces are added to make all the labels match in length. Once this
@@ -192,25 +162,25 @@ func labeledOutput(content ...labeledContent) string {
 
 // Implements asserts that an object is implemented by the specified interface.
 //
-//    assert.Implements(t, (*MyInterface)(nil), new(MyObject))
-func Implements(interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Implements(interfaceObject, object, msgAndArgs)
+//	assert.Implements(t, (*MyInterface)(nil), new(MyObject))
+func Implements(interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Implements(std.newCall("Implements", nil, nil), interfaceObject, object, msgAndArgs...)
 }
 
 // IsType asserts that the specified objects are of the same type.
-func IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_IsType(expectedType, object, msgAndArgs)
+func IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_IsType(std.newCall("IsType", nil, nil), expectedType, object, msgAndArgs...)
 }
 
 // Equal asserts that two objects are equal.
 //
-//    assert.Equal(t, 123, 123)
+//	assert.Equal(t, 123, 123)
 //
 // Pointer variable equality is determined based on the equality of the
 // referenced values (as opposed to the memory addresses). Function equality
 // cannot be determined and will always fail.
-func Equal(expected, actual interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Equal(expected, actual, msgAndArgs)
+func Equal(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Equal(std.newCall("Equal", expected, actual), expected, actual, msgAndArgs...)
 }
 
 // validateEqualArgs checks whether provided arguments can be safely used in the
@@ -228,22 +198,22 @@ func validateEqualArgs(expected, actual interface{}) error {
 
 // Same asserts that two pointers reference the same object.
 //
-//    assert.Same(t, ptr1, ptr2)
+//	assert.Same(t, ptr1, ptr2)
 //
 // Both arguments must be pointer variables. Pointer variable sameness is
 // determined based on the equality of both type and value.
-func Same(expected, actual interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Same(expected, actual, msgAndArgs)
+func Same(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Same(std.newCall("Same", expected, actual), expected, actual, msgAndArgs...)
 }
 
 // NotSame asserts that two pointers do not reference the same object.
 //
-//    assert.NotSame(t, ptr1, ptr2)
+//	assert.NotSame(t, ptr1, ptr2)
 //
 // Both arguments must be pointer variables. Pointer variable sameness is
 // determined based on the equality of both type and value.
-func NotSame(expected, actual interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_NotSame(expected, actual, msgAndArgs)
+func NotSame(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotSame(std.newCall("NotSame", expected, actual), expected, actual, msgAndArgs...)
 }
 
 // samePointers compares two generic interface objects and returns whether
@@ -297,22 +267,24 @@ func truncatingFormat(data interface{}) string {
 // EqualValues asserts that two objects are equal or convertable to the same types
 // and equal.
 //
-//    assert.EqualValues(t, uint32(123), int32(123))
-func EqualValues(expected, actual interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_EqualValues(expected, actual, msgAndArgs)
+//	assert.EqualValues(t, uint32(123), int32(123))
+func EqualValues(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_EqualValues(std.newCall("EqualValues", expected, actual), expected, actual, msgAndArgs...)
 }
 
 // Exactly asserts that two objects are equal in value and type.
 //
-//    assert.Exactly(t, int32(123), int64(123))
-func Exactly(expected, actual interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Exactly(expected, actual, msgAndArgs)
+//	assert.Exactly(t, int32(123), int64(123))
+func Exactly(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Exactly(std.newCall("Exactly", expected, actual), expected, actual, msgAndArgs...)
 }
 
 // NotNil asserts that the specified object is not nil.
 //
-//    assert.NotNil(t, err)
-func NotNil(object interface{}, msgAndArgs ...interface{}) { debuggoGen_NotNil(object, msgAndArgs) }
+//	assert.NotNil(t, err)
+func NotNil(object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotNil(std.newCall("NotNil", nil, nil), object, msgAndArgs...)
+}
 
 // containsKind checks if a specified kind in the slice of kinds.
 func containsKind(kinds []reflect.Kind, kind reflect.Kind) bool {
@@ -349,8 +321,10 @@ func isNil(object interface{}) bool {
 
 // Nil asserts that the specified object is nil.
 //
-//    assert.Nil(t, err)
-func Nil(object interface{}, msgAndArgs ...interface{}) { debuggoGen_Nil(object, msgAndArgs) }
+//	assert.Nil(t, err)
+func Nil(object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Nil(std.newCall("Nil", nil, nil), object, msgAndArgs...)
+}
 
 // isEmpty gets whether the specified object is considered empty or not.
 func isEmpty(object interface{}) bool {
@@ -383,16 +357,20 @@ func isEmpty(object interface{}) bool {
 // Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
 // a slice or a channel with len == 0.
 //
-//  assert.Empty(t, obj)
-func Empty(object interface{}, msgAndArgs ...interface{}) { debuggoGen_Empty(object, msgAndArgs) }
+//	assert.Empty(t, obj)
+func Empty(object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Empty(std.newCall("Empty", nil, nil), object, msgAndArgs...)
+}
 
 // NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false, 0 or either
 // a slice or a channel with len == 0.
 //
-//  if assert.NotEmpty(t, obj) {
-//    assert.Equal(t, "two", obj[1])
-//  }
-func NotEmpty(object interface{}, msgAndArgs ...interface{}) { debuggoGen_NotEmpty(object, msgAndArgs) }
+//	if assert.NotEmpty(t, obj) {
+//	  assert.Equal(t, "two", obj[1])
+//	}
+func NotEmpty(object interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotEmpty(std.newCall("NotEmpty", nil, nil), object, msgAndArgs...)
+}
 
 // getLen try to get length of object.
 // return (false, 0) if impossible.
@@ -409,36 +387,40 @@ func getLen(x interface{}) (ok bool, length int) {
 // Len asserts that the specified object has specific length.
 // Len also fails if the object has a type that len() not accept.
 //
-//    assert.Len(t, mySlice, 3)
-func Len(object interface{}, length int, msgAndArgs ...interface{}) {
-	debuggoGen_Len(object, length, msgAndArgs)
+//	assert.Len(t, mySlice, 3)
+func Len(object interface{}, length int, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Len(std.newCall("Len", nil, nil), object, length, msgAndArgs...)
 }
 
 // True asserts that the specified value is true.
 //
-//    assert.True(t, myBool)
-func True(value bool, msgAndArgs ...interface{}) { debuggoGen_True(value, msgAndArgs) }
+//	assert.True(t, myBool)
+func True(value bool, msgAndArgs ...interface{}) bool {
+	return debuggoGen_True(std.newCall("True", nil, nil), value, msgAndArgs...)
+}
 
 // False asserts that the specified value is false.
 //
-//    assert.False(t, myBool)
-func False(value bool, msgAndArgs ...interface{}) { debuggoGen_False(value, msgAndArgs) }
+//	assert.False(t, myBool)
+func False(value bool, msgAndArgs ...interface{}) bool {
+	return debuggoGen_False(std.newCall("False", nil, nil), value, msgAndArgs...)
+}
 
 // NotEqual asserts that the specified values are NOT equal.
 //
-//    assert.NotEqual(t, obj1, obj2)
+//	assert.NotEqual(t, obj1, obj2)
 //
 // Pointer variable equality is determined based on the equality of the
 // referenced values (as opposed to the memory addresses).
-func NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_NotEqual(expected, actual, msgAndArgs)
+func NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotEqual(std.newCall("NotEqual", expected, actual), expected, actual, msgAndArgs...)
 }
 
 // NotEqualValues asserts that two objects are not equal even when converted to the same type
 //
-//    assert.NotEqualValues(t, obj1, obj2)
-func NotEqualValues(expected, actual interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_NotEqualValues(expected, actual, msgAndArgs)
+//	assert.NotEqualValues(t, obj1, obj2)
+func NotEqualValues(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotEqualValues(std.newCall("NotEqualValues", expected, actual), expected, actual, msgAndArgs...)
 }
 
 // containsElement try loop over the list check if the list includes the element.
@@ -483,39 +465,37 @@ func includeElement(list interface{}, element interface{}) (ok, found bool) {
 // Contains asserts that the specified string, list(array, slice...) or map contains the
 // specified substring or element.
 //
-//    assert.Contains(t, "Hello World", "World")
-//    assert.Contains(t, ["Hello", "World"], "World")
-//    assert.Contains(t, {"Hello": "World"}, "Hello")
-func Contains(s, contains interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Contains(s, contains, msgAndArgs)
+//	assert.Contains(t, "Hello World", "World")
+//	assert.Contains(t, ["Hello", "World"], "World")
+//	assert.Contains(t, {"Hello": "World"}, "Hello")
+func Contains(s, contains interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Contains(std.newCall("Contains", nil, nil), s, contains, msgAndArgs...)
 }
 
 // NotContains asserts that the specified string, list(array, slice...) or map does NOT contain the
 // specified substring or element.
 //
-//    assert.NotContains(t, "Hello World", "Earth")
-//    assert.NotContains(t, ["Hello", "World"], "Earth")
-//    assert.NotContains(t, {"Hello": "World"}, "Earth")
-func NotContains(s, contains interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_NotContains(s, contains, msgAndArgs)
+//	assert.NotContains(t, "Hello World", "Earth")
+//	assert.NotContains(t, ["Hello", "World"], "Earth")
+//	assert.NotContains(t, {"Hello": "World"}, "Earth")
+func NotContains(s, contains interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotContains(std.newCall("NotContains", nil, nil), s, contains, msgAndArgs...)
 }
 
 // Subset asserts that the specified list(array, slice...) contains all
 // elements given in the specified subset(array, slice...).
 //
-//    assert.Subset(t, [1, 2, 3], [1, 2], "But [1, 2, 3] does contain [1, 2]")
-func Subset(list, subset interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Subset(list, subset, msgAndArgs)
+//	assert.Subset(t, [1, 2, 3], [1, 2], "But [1, 2, 3] does contain [1, 2]")
+func Subset(list, subset interface{}, msgAndArgs ...interface{}) (ok bool) {
+	return debuggoGen_Subset(std.newCall("Subset", nil, nil), list, subset, msgAndArgs...)
 }
 
-// we consider nil to be equal to the nil set
-
 // NotSubset asserts that the specified list(array, slice...) contains not all
 // elements given in the specified subset(array, slice...).
 //
-//    assert.NotSubset(t, [1, 3, 4], [1, 2], "But [1, 3, 4] does not contain [1, 2]")
-func NotSubset(list, subset interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_NotSubset(list, subset, msgAndArgs)
+//	assert.NotSubset(t, [1, 3, 4], [1, 2], "But [1, 3, 4] does not contain [1, 2]")
+func NotSubset(list, subset interface{}, msgAndArgs ...interface{}) (ok bool) {
+	return debuggoGen_NotSubset(std.newCall("NotSubset", nil, nil), list, subset, msgAndArgs...)
 }
 
 // ElementsMatch asserts that the specified listA(array, slice...) is equal to specified
@@ -523,15 +503,15 @@ func NotSubset(list, subset interface{}, msgAndArgs ...interface{}) {
 // the number of appearances of each of them in both lists should match.
 //
 // assert.ElementsMatch(t, [1, 3, 2, 3], [1, 3, 3, 2])
-func ElementsMatch(listA, listB interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_ElementsMatch(listA, listB, msgAndArgs)
+func ElementsMatch(listA, listB interface{}, msgAndArgs ...interface{}) (ok bool) {
+	return debuggoGen_ElementsMatch(std.newCall("ElementsMatch", nil, nil), listA, listB, msgAndArgs...)
 }
 
 // isList checks that the provided value is array or slice.
-func isList(list interface{}, msgAndArgs ...interface{}) (ok bool) {
+func isList(t TestingT, list interface{}, msgAndArgs ...interface{}) (ok bool) {
 	kind := reflect.TypeOf(list).Kind()
 	if kind != reflect.Array && kind != reflect.Slice {
-		return debuggoGen_Fail(fmt.Sprintf("%q has an unsupported type %s, expecting array or slice", list, kind),
+		return debuggoGen_Fail(t, fmt.Sprintf("%q has an unsupported type %s, expecting array or slice", list, kind),
 			msgAndArgs...)
 	}
 	return true
@@ -598,7 +578,9 @@ func formatListDiff(listA, listB interface{}, extraA, extraB []interface{}) stri
 }
 
 // Condition uses a Comparison to assert a complex condition.
-func Condition(comp Comparison, msgAndArgs ...interface{}) { debuggoGen_Condition(comp, msgAndArgs) }
+func Condition(comp Comparison, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Condition(std.newCall("Condition", nil, nil), comp, msgAndArgs...)
+}
 
 // PanicTestFunc defines a func that should be passed to the assert.Panics and assert.NotPanics
 // methods, and represents a simple func that takes no arguments, and returns nothing.
@@ -630,36 +612,40 @@ func didPanic(f PanicTestFunc) (bool, interface{}, string) {
 
 // Panics asserts that the code inside the specified PanicTestFunc panics.
 //
-//   assert.Panics(t, func(){ GoCrazy() })
-func Panics(f PanicTestFunc, msgAndArgs ...interface{}) { debuggoGen_Panics(f, msgAndArgs) }
+//	assert.Panics(t, func(){ GoCrazy() })
+func Panics(f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Panics(std.newCall("Panics", nil, nil), f, msgAndArgs...)
+}
 
 // PanicsWithValue asserts that the code inside the specified PanicTestFunc panics, and that
 // the recovered panic value equals the expected panic value.
 //
-//   assert.PanicsWithValue(t, "crazy error", func(){ GoCrazy() })
-func PanicsWithValue(expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) {
-	debuggoGen_PanicsWithValue(expected, f, msgAndArgs)
+//	assert.PanicsWithValue(t, "crazy error", func(){ GoCrazy() })
+func PanicsWithValue(expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	return debuggoGen_PanicsWithValue(std.newCall("PanicsWithValue", expected, nil), expected, f, msgAndArgs...)
 }
 
 // PanicsWithError asserts that the code inside the specified PanicTestFunc
 // panics, and that the recovered panic value is an error that satisfies the
 // EqualError comparison.
 //
-//   assert.PanicsWithError(t, "crazy error", func(){ GoCrazy() })
-func PanicsWithError(errString string, f PanicTestFunc, msgAndArgs ...interface{}) {
-	debuggoGen_PanicsWithError(errString, f, msgAndArgs)
+//	assert.PanicsWithError(t, "crazy error", func(){ GoCrazy() })
+func PanicsWithError(errString string, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	return debuggoGen_PanicsWithError(std.newCall("PanicsWithError", nil, nil), errString, f, msgAndArgs...)
 }
 
 // NotPanics asserts that the code inside the specified PanicTestFunc does NOT panic.
 //
-//   assert.NotPanics(t, func(){ RemainCalm() })
-func NotPanics(f PanicTestFunc, msgAndArgs ...interface{}) { debuggoGen_NotPanics(f, msgAndArgs) }
+//	assert.NotPanics(t, func(){ RemainCalm() })
+func NotPanics(f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotPanics(std.newCall("NotPanics", nil, nil), f, msgAndArgs...)
+}
 
 // WithinDuration asserts that the two times are within duration delta of each other.
 //
-//   assert.WithinDuration(t, time.Now(), time.Now(), 10*time.Second)
-func WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) {
-	debuggoGen_WithinDuration(expected, actual, delta, msgAndArgs)
+//	assert.WithinDuration(t, time.Now(), time.Now(), 10*time.Second)
+func WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) bool {
+	return debuggoGen_WithinDuration(std.newCall("WithinDuration", expected, actual), expected, actual, delta, msgAndArgs...)
 }
 
 func toFloat(x interface{}) (float64, bool) {
@@ -702,19 +688,19 @@ func toFloat(x interface{}) (float64, bool) {
 
 // InDelta asserts that the two numerals are within delta of each other.
 //
-// 	 assert.InDelta(t, math.Pi, 22/7.0, 0.01)
-func InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
-	debuggoGen_InDelta(expected, actual, delta, msgAndArgs)
+//	assert.InDelta(t, math.Pi, 22/7.0, 0.01)
+func InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	return debuggoGen_InDelta(std.newCall("InDelta", expected, actual), expected, actual, delta, msgAndArgs...)
 }
 
 // InDeltaSlice is the same as InDelta, except it compares two slices.
-func InDeltaSlice(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
-	debuggoGen_InDeltaSlice(expected, actual, delta, msgAndArgs)
+func InDeltaSlice(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	return debuggoGen_InDeltaSlice(std.newCall("InDeltaSlice", expected, actual), expected, actual, delta, msgAndArgs...)
 }
 
 // InDeltaMapValues is the same as InDelta, but it compares all values between two maps. Both maps must have exactly the same keys.
-func InDeltaMapValues(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
-	debuggoGen_InDeltaMapValues(expected, actual, delta, msgAndArgs)
+func InDeltaMapValues(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	return debuggoGen_InDeltaMapValues(std.newCall("InDeltaMapValues", expected, actual), expected, actual, delta, msgAndArgs...)
 }
 
 func calcRelativeError(expected, actual interface{}) (float64, error) {
@@ -740,46 +726,44 @@ func calcRelativeError(expected, actual interface{}) (float64, error) {
 }
 
 // InEpsilon asserts that expected and actual have a relative error less than epsilon
-func InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
-	debuggoGen_InEpsilon(expected, actual, epsilon, msgAndArgs)
+func InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+	return debuggoGen_InEpsilon(std.newCall("InEpsilon", expected, actual), expected, actual, epsilon, msgAndArgs...)
 }
 
 // InEpsilonSlice is the same as InEpsilon, except it compares each value from two slices.
-func InEpsilonSlice(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
-	debuggoGen_InEpsilonSlice(expected, actual, epsilon, msgAndArgs)
+func InEpsilonSlice(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+	return debuggoGen_InEpsilonSlice(std.newCall("InEpsilonSlice", expected, actual), expected, actual, epsilon, msgAndArgs...)
 }
 
-/*
-	Errors
-*/
-
 // NoError asserts that a function returned no error (i.e. `nil`).
 //
-//   actualObj, err := SomeFunction()
-//   if assert.NoError(t, err) {
-//	   assert.Equal(t, expectedObj, actualObj)
-//   }
-func NoError(err error, msgAndArgs ...interface{}) { debuggoGen_NoError(err, msgAndArgs) }
+//	  actualObj, err := SomeFunction()
+//	  if assert.NoError(t, err) {
+//		   assert.Equal(t, expectedObj, actualObj)
+//	  }
+func NoError(err error, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NoError(std.newCall("NoError", nil, nil), err, msgAndArgs...)
+}
 
 // Error asserts that a function returned an error (i.e. not `nil`).
 //
-//   actualObj, err := SomeFunction()
-//   if assert.Error(t, err) {
-//	   assert.Equal(t, expectedError, err)
-//   }
-func Error(err error, msgAndArgs ...interface{}) { debuggoGen_Error(err, msgAndArgs) }
+//	  actualObj, err := SomeFunction()
+//	  if assert.Error(t, err) {
+//		   assert.Equal(t, expectedError, err)
+//	  }
+func Error(err error, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Error(std.newCall("Error", nil, nil), err, msgAndArgs...)
+}
 
 // EqualError asserts that a function returned an error (i.e. not `nil`)
 // and that it is equal to the provided error.
 //
-//   actualObj, err := SomeFunction()
-//   assert.EqualError(t, err,  expectedErrorString)
-func EqualError(theError error, errString string, msgAndArgs ...interface{}) {
-	debuggoGen_EqualError(theError, errString, msgAndArgs)
+//	actualObj, err := SomeFunction()
+//	assert.EqualError(t, err,  expectedErrorString)
+func EqualError(theError error, errString string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_EqualError(std.newCall("EqualError", nil, nil), theError, errString, msgAndArgs...)
 }
 
-// don't need to use deep equals here, we know they are both strings
-
 // matchRegexp return true if a specified regexp matches a string.
 func matchRegexp(rx interface{}, str interface{}) bool {
 
@@ -796,52 +780,64 @@ func matchRegexp(rx interface{}, str interface{}) bool {
 
 // Regexp asserts that a specified regexp matches a string.
 //
-//  assert.Regexp(t, regexp.MustCompile("start"), "it's starting")
-//  assert.Regexp(t, "start...$", "it's not starting")
-func Regexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_Regexp(rx, str, msgAndArgs)
+//	assert.Regexp(t, regexp.MustCompile("start"), "it's starting")
+//	assert.Regexp(t, "start...$", "it's not starting")
+func Regexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Regexp(std.newCall("Regexp", nil, nil), rx, str, msgAndArgs...)
 }
 
 // NotRegexp asserts that a specified regexp does not match a string.
 //
-//  assert.NotRegexp(t, regexp.MustCompile("starts"), "it's starting")
-//  assert.NotRegexp(t, "^start", "it's not starting")
-func NotRegexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_NotRegexp(rx, str, msgAndArgs)
+//	assert.NotRegexp(t, regexp.MustCompile("starts"), "it's starting")
+//	assert.NotRegexp(t, "^start", "it's not starting")
+func NotRegexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotRegexp(std.newCall("NotRegexp", nil, nil), rx, str, msgAndArgs...)
 }
 
 // Zero asserts that i is the zero value for its type.
-func Zero(i interface{}, msgAndArgs ...interface{}) { debuggoGen_Zero(i, msgAndArgs) }
+func Zero(i interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Zero(std.newCall("Zero", nil, nil), i, msgAndArgs...)
+}
 
 // NotZero asserts that i is not the zero value for its type.
-func NotZero(i interface{}, msgAndArgs ...interface{}) { debuggoGen_NotZero(i, msgAndArgs) }
+func NotZero(i interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotZero(std.newCall("NotZero", nil, nil), i, msgAndArgs...)
+}
 
 // FileExists checks whether a file exists in the given path. It also fails if
 // the path points to a directory or there is an error when trying to check the file.
-func FileExists(path string, msgAndArgs ...interface{}) { debuggoGen_FileExists(path, msgAndArgs) }
+func FileExists(path string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_FileExists(std.newCall("FileExists", nil, nil), path, msgAndArgs...)
+}
 
 // NoFileExists checks whether a file does not exist in a given path. It fails
 // if the path points to an existing _file_ only.
-func NoFileExists(path string, msgAndArgs ...interface{}) { debuggoGen_NoFileExists(path, msgAndArgs) }
+func NoFileExists(path string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NoFileExists(std.newCall("NoFileExists", nil, nil), path, msgAndArgs...)
+}
 
 // DirExists checks whether a directory exists in the given path. It also fails
 // if the path is a file rather a directory or there is an error checking whether it exists.
-func DirExists(path string, msgAndArgs ...interface{}) { debuggoGen_DirExists(path, msgAndArgs) }
+func DirExists(path string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_DirExists(std.newCall("DirExists", nil, nil), path, msgAndArgs...)
+}
 
 // NoDirExists checks whether a directory does not exist in the given path.
 // It fails if the path points to an existing _directory_ only.
-func NoDirExists(path string, msgAndArgs ...interface{}) { debuggoGen_NoDirExists(path, msgAndArgs) }
+func NoDirExists(path string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NoDirExists(std.newCall("NoDirExists", nil, nil), path, msgAndArgs...)
+}
 
 // JSONEq asserts that two JSON strings are equivalent.
 //
-//  assert.JSONEq(t, `{"hello": "world", "foo": "bar"}`, `{"foo": "bar", "hello": "world"}`)
-func JSONEq(expected string, actual string, msgAndArgs ...interface{}) {
-	debuggoGen_JSONEq(expected, actual, msgAndArgs)
+//	assert.JSONEq(t, `{"hello": "world", "foo": "bar"}`, `{"foo": "bar", "hello": "world"}`)
+func JSONEq(expected string, actual string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_JSONEq(std.newCall("JSONEq", expected, actual), expected, actual, msgAndArgs...)
 }
 
 // YAMLEq asserts that two YAML strings are equivalent.
-func YAMLEq(expected string, actual string, msgAndArgs ...interface{}) {
-	debuggoGen_YAMLEq(expected, actual, msgAndArgs)
+func YAMLEq(expected string, actual string, msgAndArgs ...interface{}) bool {
+	return debuggoGen_YAMLEq(std.newCall("YAMLEq", expected, actual), expected, actual, msgAndArgs...)
 }
 
 func typeAndKind(v interface{}) (reflect.Type, reflect.Kind) {
@@ -908,6 +904,7 @@ var spewConfig = spew.ConfigState{
 	DisableCapacities:       true,
 	SortKeys:                true,
 	DisableMethods:          true,
+	MaxDepth:                10,
 }
 
 type tHelper interface {
@@ -917,35 +914,35 @@ type tHelper interface {
 // Eventually asserts that given condition will be met in waitFor time,
 // periodically checking target function each tick.
 //
-//    assert.Eventually(t, func() bool { return true; }, time.Second, 10*time.Millisecond)
-func Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) {
-	debuggoGen_Eventually(condition, waitFor, tick, msgAndArgs)
+//	assert.Eventually(t, func() bool { return true; }, time.Second, 10*time.Millisecond)
+func Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Eventually(std.newCall("Eventually", nil, nil), condition, waitFor, tick, msgAndArgs...)
 }
 
 // Never asserts that the given condition doesn't satisfy in waitFor time,
 // periodically checking the target function each tick.
 //
-//    assert.Never(t, func() bool { return false; }, time.Second, 10*time.Millisecond)
-func Never(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) {
-	debuggoGen_Never(condition, waitFor, tick, msgAndArgs)
+//	assert.Never(t, func() bool { return false; }, time.Second, 10*time.Millisecond)
+func Never(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) bool {
+	return debuggoGen_Never(std.newCall("Never", nil, nil), condition, waitFor, tick, msgAndArgs...)
 }
 
 // ErrorIs asserts that at least one of the errors in err's chain matches target.
 // This is a wrapper for errors.Is.
-func ErrorIs(err, target error, msgAndArgs ...interface{}) {
-	debuggoGen_ErrorIs(err, target, msgAndArgs)
+func ErrorIs(err, target error, msgAndArgs ...interface{}) bool {
+	return debuggoGen_ErrorIs(std.newCall("ErrorIs", nil, nil), err, target, msgAndArgs...)
 }
 
 // NotErrorIs asserts that at none of the errors in err's chain matches target.
 // This is a wrapper for errors.Is.
-func NotErrorIs(err, target error, msgAndArgs ...interface{}) {
-	debuggoGen_NotErrorIs(err, target, msgAndArgs)
+func NotErrorIs(err, target error, msgAndArgs ...interface{}) bool {
+	return debuggoGen_NotErrorIs(std.newCall("NotErrorIs", nil, nil), err, target, msgAndArgs...)
 }
 
 // ErrorAs asserts that at least one of the errors in err's chain matches target, and if so, sets target to that error value.
 // This is a wrapper for errors.As.
-func ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_ErrorAs(err, target, msgAndArgs)
+func ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_ErrorAs(std.newCall("ErrorAs", nil, nil), err, target, msgAndArgs...)
 }
 
 func buildErrorChainString(err error) string {
@@ -962,6 +959,10 @@ func buildErrorChainString(err error) string {
 	return chain
 }
 
+/*
+	Helper functions
+*/
+
 func debuggoGen_ObjectsAreEqual(expected, actual interface{}) bool {
 	if expected == nil || actual == nil {
 		return expected == actual
@@ -993,13 +994,17 @@ func debuggoGen_ObjectsAreEqualValues(expected, actual interface{}) bool {
 	}
 	expectedValue := reflect.ValueOf(expected)
 	if expectedValue.IsValid() && expectedValue.Type().ConvertibleTo(actualType) {
-
+		// Attempt comparison after type conversion
 		return reflect.DeepEqual(expectedValue.Convert(actualType).Interface(), actual)
 	}
 
 	return false
 }
 
+/* CallerInfo is necessary because the assert functions use the testing object
+internally, causing it to print the file:line of the assert method, rather than where
+the problem actually occurred in calling code.*/
+
 func debuggoGen_CallerInfo() []string {
 
 	var pc uintptr
@@ -1012,10 +1017,12 @@ func debuggoGen_CallerInfo() []string {
 	for i := 0; ; i++ {
 		pc, file, line, ok = runtime.Caller(i)
 		if !ok {
-
+			// The breaks below failed to terminate the loop, and we ran off the
+			// end of the call stack.
 			break
 		}
 
+		// This is a huge edge case, but it will panic if this is the case, see #180
 		if file == "<autogenerated>" {
 			break
 		}
@@ -1026,6 +1033,11 @@ func debuggoGen_CallerInfo() []string {
 		}
 		name = f.Name()
 
+		// testing.tRunner is the standard library function that calls
+		// tests. Subtests are called directly by tRunner, without going through
+		// the Test/Benchmark/Example function that contains the t.Run calls, so
+		// with subtests we should break when we hit tRunner, without adding it
+		// to the list of callers.
 		if name == "testing.tRunner" {
 			break
 		}
@@ -1039,6 +1051,7 @@ func debuggoGen_CallerInfo() []string {
 			}
 		}
 
+		// Drop the package
 		segments := strings.Split(name, ".")
 		name = segments[len(segments)-1]
 		if isTest(name, "Test") ||
@@ -1051,62 +1064,78 @@ func debuggoGen_CallerInfo() []string {
 	return callers
 }
 
-func debuggoGen_FailNow(failureMessage string, msgAndArgs ...interface{}) bool {
-	debuggoGen_Fail(failureMessage, msgAndArgs...)
+func debuggoGen_FailNow(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	debuggoGen_Fail(t, failureMessage, msgAndArgs...)
+
+	// We cannot extend TestingT with FailNow() and
+	// maintain backwards compatibility, so we fallback
+	// to panicking when FailNow is not available in
+	// TestingT.
+	// See issue #263
 
+	if t, ok := t.(failNower); ok {
+		t.FailNow()
+	} else {
+		panic("test failed and t is missing `FailNow()`")
+	}
 	return false
 }
 
-func debuggoGen_Fail(failureMessage string, msgAndArgs ...interface{}) bool {
-
-	content := []labeledContent{
-		{"Error Trace", strings.Join(debuggoGen_CallerInfo(), "\n\t\t\t")},
-		{"Error", failureMessage},
+func debuggoGen_Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
 	}
 
 	message := messageFromMsgAndArgs(msgAndArgs...)
-	if len(message) > 0 {
-		content = append(content, labeledContent{"Messages", message})
-	}
-	panic(fmt.Sprint("\n%s", ""+labeledOutput(content...)))
+	reportFailure(t, failureMessage, message)
 
 	return false
 }
 
-func debuggoGen_Implements(interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_Implements(t TestingT, interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	interfaceType := reflect.TypeOf(interfaceObject).Elem()
 
 	if object == nil {
-		return debuggoGen_Fail(fmt.Sprintf("Cannot check if nil implements %v", interfaceType), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Cannot check if nil implements %v", interfaceType), msgAndArgs...)
 	}
 	if !reflect.TypeOf(object).Implements(interfaceType) {
-		return debuggoGen_Fail(fmt.Sprintf("%T must implement %v", object, interfaceType), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%T must implement %v", object, interfaceType), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_IsType(t TestingT, expectedType interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	if !debuggoGen_ObjectsAreEqual(reflect.TypeOf(object), reflect.TypeOf(expectedType)) {
-		return debuggoGen_Fail(fmt.Sprintf("Object expected to be of type %v, but was %v", reflect.TypeOf(expectedType), reflect.TypeOf(object)), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Object expected to be of type %v, but was %v", reflect.TypeOf(expectedType), reflect.TypeOf(object)), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_Equal(expected, actual interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if err := validateEqualArgs(expected, actual); err != nil {
-		return debuggoGen_Fail(fmt.Sprintf("Invalid operation: %#v == %#v (%s)",
+		return debuggoGen_Fail(t, fmt.Sprintf("Invalid operation: %#v == %#v (%s)",
 			expected, actual, err), msgAndArgs...)
 	}
 
 	if !debuggoGen_ObjectsAreEqual(expected, actual) {
 		diff := diff(expected, actual)
 		expected, actual = formatUnequalValues(expected, actual)
-		return debuggoGen_Fail(fmt.Sprintf("Not equal: \n"+
+		return debuggoGen_Fail(t, fmt.Sprintf("Not equal: \n"+
 			"expected: %s\n"+
 			"actual  : %s%s", expected, actual, diff), msgAndArgs...)
 	}
@@ -1115,10 +1144,13 @@ func debuggoGen_Equal(expected, actual interface{}, msgAndArgs ...interface{}) b
 
 }
 
-func debuggoGen_Same(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_Same(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	if !samePointers(expected, actual) {
-		return debuggoGen_Fail(fmt.Sprintf("Not same: \n"+
+		return debuggoGen_Fail(t, fmt.Sprintf("Not same: \n"+
 			"expected: %p %#v\n"+
 			"actual  : %p %#v", expected, expected, actual, actual), msgAndArgs...)
 	}
@@ -1126,22 +1158,28 @@ func debuggoGen_Same(expected, actual interface{}, msgAndArgs ...interface{}) bo
 	return true
 }
 
-func debuggoGen_NotSame(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_NotSame(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	if samePointers(expected, actual) {
-		return debuggoGen_Fail(fmt.Sprintf(
+		return debuggoGen_Fail(t, fmt.Sprintf(
 			"Expected and actual point to the same object: %p %#v",
 			expected, expected), msgAndArgs...)
 	}
 	return true
 }
 
-func debuggoGen_EqualValues(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_EqualValues(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	if !debuggoGen_ObjectsAreEqualValues(expected, actual) {
 		diff := diff(expected, actual)
 		expected, actual = formatUnequalValues(expected, actual)
-		return debuggoGen_Fail(fmt.Sprintf("Not equal: \n"+
+		return debuggoGen_Fail(t, fmt.Sprintf("Not equal: \n"+
 			"expected: %s\n"+
 			"actual  : %s%s", expected, actual, diff), msgAndArgs...)
 	}
@@ -1150,144 +1188,176 @@ func debuggoGen_EqualValues(expected, actual interface{}, msgAndArgs ...interfac
 
 }
 
-func debuggoGen_Exactly(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_Exactly(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	aType := reflect.TypeOf(expected)
 	bType := reflect.TypeOf(actual)
 
 	if aType != bType {
-		return debuggoGen_Fail(fmt.Sprintf("Types expected to match exactly\n\t%v != %v", aType, bType), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Types expected to match exactly\n\t%v != %v", aType, bType), msgAndArgs...)
 	}
 
-	return debuggoGen_Equal(expected, actual, msgAndArgs...)
+	return debuggoGen_Equal(t, expected, actual, msgAndArgs...)
 
 }
 
-func debuggoGen_NotNil(object interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_NotNil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 	if !isNil(object) {
 		return true
 	}
-
-	return debuggoGen_Fail("Expected value not to be nil.", msgAndArgs...)
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return debuggoGen_Fail(t, "Expected value not to be nil.", msgAndArgs...)
 }
 
-func debuggoGen_Nil(object interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_Nil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 	if isNil(object) {
 		return true
 	}
-
-	return debuggoGen_Fail(fmt.Sprintf("Expected nil, but got: %#v", object), msgAndArgs...)
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return debuggoGen_Fail(t, fmt.Sprintf("Expected nil, but got: %#v", object), msgAndArgs...)
 }
 
-func debuggoGen_Empty(object interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_Empty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 	pass := isEmpty(object)
 	if !pass {
-		debuggoGen_Fail(fmt.Sprintf("Should be empty, but was %v", object), msgAndArgs...)
+		if h, ok := t.(tHelper); ok {
+			h.Helper()
+		}
+		debuggoGen_Fail(t, fmt.Sprintf("Should be empty, but was %v", object), msgAndArgs...)
 	}
 
 	return pass
 
 }
 
-func debuggoGen_NotEmpty(object interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_NotEmpty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 	pass := !isEmpty(object)
 	if !pass {
-		debuggoGen_Fail(fmt.Sprintf("Should NOT be empty, but was %v", object), msgAndArgs...)
+		if h, ok := t.(tHelper); ok {
+			h.Helper()
+		}
+		debuggoGen_Fail(t, fmt.Sprintf("Should NOT be empty, but was %v", object), msgAndArgs...)
 	}
 
 	return pass
 
 }
 
-func debuggoGen_Len(object interface{}, length int, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_Len(t TestingT, object interface{}, length int, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	ok, l := getLen(object)
 	if !ok {
-		return debuggoGen_Fail(fmt.Sprintf("\"%s\" could not be applied builtin len()", object), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("\"%v\" could not be applied builtin len()", object), msgAndArgs...)
 	}
 
 	if l != length {
-		return debuggoGen_Fail(fmt.Sprintf("\"%s\" should have %d item(s), but has %d", object, length, l), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("\"%v\" should have %d item(s), but has %d", object, length, l), msgAndArgs...)
 	}
 	return true
 }
 
-func debuggoGen_True(value bool, msgAndArgs ...interface{}) bool {
+func debuggoGen_True(t TestingT, value bool, msgAndArgs ...interface{}) bool {
 	if !value {
-
-		return debuggoGen_Fail("Should be true", msgAndArgs...)
+		if h, ok := t.(tHelper); ok {
+			h.Helper()
+		}
+		return debuggoGen_Fail(t, "Should be true", msgAndArgs...)
 	}
 
 	return true
 
 }
 
-func debuggoGen_False(value bool, msgAndArgs ...interface{}) bool {
+func debuggoGen_False(t TestingT, value bool, msgAndArgs ...interface{}) bool {
 	if value {
-
-		return debuggoGen_Fail("Should be false", msgAndArgs...)
+		if h, ok := t.(tHelper); ok {
+			h.Helper()
+		}
+		return debuggoGen_Fail(t, "Should be false", msgAndArgs...)
 	}
 
 	return true
 
 }
 
-func debuggoGen_NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if err := validateEqualArgs(expected, actual); err != nil {
-		return debuggoGen_Fail(fmt.Sprintf("Invalid operation: %#v != %#v (%s)",
+		return debuggoGen_Fail(t, fmt.Sprintf("Invalid operation: %#v != %#v (%s)",
 			expected, actual, err), msgAndArgs...)
 	}
 
 	if debuggoGen_ObjectsAreEqual(expected, actual) {
-		return debuggoGen_Fail(fmt.Sprintf("Should not be: %#v\n", actual), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Should not be: %#v\n", actual), msgAndArgs...)
 	}
 
 	return true
 
 }
 
-func debuggoGen_NotEqualValues(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_NotEqualValues(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	if debuggoGen_ObjectsAreEqualValues(expected, actual) {
-		return debuggoGen_Fail(fmt.Sprintf("Should not be: %#v\n", actual), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Should not be: %#v\n", actual), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_Contains(s, contains interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_Contains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	ok, found := includeElement(s, contains)
 	if !ok {
-		return debuggoGen_Fail(fmt.Sprintf("%#v could not be applied builtin len()", s), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%#v could not be applied builtin len()", s), msgAndArgs...)
 	}
 	if !found {
-		return debuggoGen_Fail(fmt.Sprintf("%#v does not contain %#v", s, contains), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%#v does not contain %#v", s, contains), msgAndArgs...)
 	}
 
 	return true
 
 }
 
-func debuggoGen_NotContains(s, contains interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_NotContains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	ok, found := includeElement(s, contains)
 	if !ok {
-		return debuggoGen_Fail(fmt.Sprintf("\"%s\" could not be applied builtin len()", s), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("\"%v\" could not be applied builtin len()", s), msgAndArgs...)
 	}
 	if found {
-		return debuggoGen_Fail(fmt.Sprintf("\"%s\" should not contain \"%s\"", s, contains), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("\"%v\" should not contain \"%v\"", s, contains), msgAndArgs...)
 	}
 
 	return true
 
 }
 
-func debuggoGen_Subset(list, subset interface{}, msgAndArgs ...interface{}) (ok bool) {
-
+func debuggoGen_Subset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) (ok bool) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if subset == nil {
-		return true
+		return true // we consider nil to be equal to the nil set
 	}
 
 	subsetValue := reflect.ValueOf(subset)
@@ -1301,31 +1371,33 @@ func debuggoGen_Subset(list, subset interface{}, msgAndArgs ...interface{}) (ok
 	subsetKind := reflect.TypeOf(subset).Kind()
 
 	if listKind != reflect.Array && listKind != reflect.Slice {
-		return debuggoGen_Fail(fmt.Sprintf("%q has an unsupported type %s", list, listKind), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%q has an unsupported type %s", list, listKind), msgAndArgs...)
 	}
 
 	if subsetKind != reflect.Array && subsetKind != reflect.Slice {
-		return debuggoGen_Fail(fmt.Sprintf("%q has an unsupported type %s", subset, subsetKind), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%q has an unsupported type %s", subset, subsetKind), msgAndArgs...)
 	}
 
 	for i := 0; i < subsetValue.Len(); i++ {
 		element := subsetValue.Index(i).Interface()
 		ok, found := includeElement(list, element)
 		if !ok {
-			return debuggoGen_Fail(fmt.Sprintf("\"%s\" could not be applied builtin len()", list), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf("\"%v\" could not be applied builtin len()", list), msgAndArgs...)
 		}
 		if !found {
-			return debuggoGen_Fail(fmt.Sprintf("\"%s\" does not contain \"%s\"", list, element), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf("\"%v\" does not contain \"%s\"", list, element), msgAndArgs...)
 		}
 	}
 
 	return true
 }
 
-func debuggoGen_NotSubset(list, subset interface{}, msgAndArgs ...interface{}) (ok bool) {
-
+func debuggoGen_NotSubset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) (ok bool) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if subset == nil {
-		return debuggoGen_Fail(fmt.Sprintf("nil is the empty set which is a subset of every set"), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("nil is the empty set which is a subset of every set"), msgAndArgs...)
 	}
 
 	subsetValue := reflect.ValueOf(subset)
@@ -1339,34 +1411,36 @@ func debuggoGen_NotSubset(list, subset interface{}, msgAndArgs ...interface{}) (
 	subsetKind := reflect.TypeOf(subset).Kind()
 
 	if listKind != reflect.Array && listKind != reflect.Slice {
-		return debuggoGen_Fail(fmt.Sprintf("%q has an unsupported type %s", list, listKind), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%q has an unsupported type %s", list, listKind), msgAndArgs...)
 	}
 
 	if subsetKind != reflect.Array && subsetKind != reflect.Slice {
-		return debuggoGen_Fail(fmt.Sprintf("%q has an unsupported type %s", subset, subsetKind), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%q has an unsupported type %s", subset, subsetKind), msgAndArgs...)
 	}
 
 	for i := 0; i < subsetValue.Len(); i++ {
 		element := subsetValue.Index(i).Interface()
 		ok, found := includeElement(list, element)
 		if !ok {
-			return debuggoGen_Fail(fmt.Sprintf("\"%s\" could not be applied builtin len()", list), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf("\"%v\" could not be applied builtin len()", list), msgAndArgs...)
 		}
 		if !found {
 			return true
 		}
 	}
 
-	return debuggoGen_Fail(fmt.Sprintf("%q is a subset of %q", subset, list), msgAndArgs...)
+	return debuggoGen_Fail(t, fmt.Sprintf("%q is a subset of %q", subset, list), msgAndArgs...)
 }
 
-func debuggoGen_ElementsMatch(listA, listB interface{}, msgAndArgs ...interface{}) (ok bool) {
-
+func debuggoGen_ElementsMatch(t TestingT, listA, listB interface{}, msgAndArgs ...interface{}) (ok bool) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if isEmpty(listA) && isEmpty(listB) {
 		return true
 	}
 
-	if !isList(listA, msgAndArgs...) || !isList(listB, msgAndArgs...) {
+	if !isList(t, listA, msgAndArgs...) || !isList(t, listB, msgAndArgs...) {
 		return false
 	}
 
@@ -1376,111 +1450,133 @@ func debuggoGen_ElementsMatch(listA, listB interface{}, msgAndArgs ...interface{
 		return true
 	}
 
-	return debuggoGen_Fail(formatListDiff(listA, listB, extraA, extraB), msgAndArgs...)
+	return debuggoGen_Fail(t, formatListDiff(listA, listB, extraA, extraB), msgAndArgs...)
 }
 
-func debuggoGen_Condition(comp Comparison, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_Condition(t TestingT, comp Comparison, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	result := comp()
 	if !result {
-		debuggoGen_Fail("Condition failed!", msgAndArgs...)
+		debuggoGen_Fail(t, "Condition failed!", msgAndArgs...)
 	}
 	return result
 }
 
-func debuggoGen_Panics(f PanicTestFunc, msgAndArgs ...interface{}) bool {
+func debuggoGen_Panics(t TestingT, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	if funcDidPanic, panicValue, _ := didPanic(f); !funcDidPanic {
-		return debuggoGen_Fail(fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_PanicsWithValue(expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+func debuggoGen_PanicsWithValue(t TestingT, expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	funcDidPanic, panicValue, panickedStack := didPanic(f)
 	if !funcDidPanic {
-		return debuggoGen_Fail(fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue), msgAndArgs...)
 	}
 	if panicValue != expected {
-		return debuggoGen_Fail(fmt.Sprintf("func %#v should panic with value:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s", f, expected, panicValue, panickedStack), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("func %#v should panic with value:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s", f, expected, panicValue, panickedStack), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_PanicsWithError(errString string, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+func debuggoGen_PanicsWithError(t TestingT, errString string, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	funcDidPanic, panicValue, panickedStack := didPanic(f)
 	if !funcDidPanic {
-		return debuggoGen_Fail(fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue), msgAndArgs...)
 	}
 	panicErr, ok := panicValue.(error)
 	if !ok || panicErr.Error() != errString {
-		return debuggoGen_Fail(fmt.Sprintf("func %#v should panic with error message:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s", f, errString, panicValue, panickedStack), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("func %#v should panic with error message:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s", f, errString, panicValue, panickedStack), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_NotPanics(f PanicTestFunc, msgAndArgs ...interface{}) bool {
+func debuggoGen_NotPanics(t TestingT, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	if funcDidPanic, panicValue, panickedStack := didPanic(f); funcDidPanic {
-		return debuggoGen_Fail(fmt.Sprintf("func %#v should not panic\n\tPanic value:\t%v\n\tPanic stack:\t%s", f, panicValue, panickedStack), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("func %#v should not panic\n\tPanic value:\t%v\n\tPanic stack:\t%s", f, panicValue, panickedStack), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) bool {
+func debuggoGen_WithinDuration(t TestingT, expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	dt := expected.Sub(actual)
 	if dt < -delta || dt > delta {
-		return debuggoGen_Fail(fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+func debuggoGen_InDelta(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	af, aok := toFloat(expected)
 	bf, bok := toFloat(actual)
 
 	if !aok || !bok {
-		return debuggoGen_Fail(fmt.Sprintf("Parameters must be numerical"), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Parameters must be numerical"), msgAndArgs...)
 	}
 
 	if math.IsNaN(af) {
-		return debuggoGen_Fail(fmt.Sprintf("Expected must not be NaN"), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Expected must not be NaN"), msgAndArgs...)
 	}
 
 	if math.IsNaN(bf) {
-		return debuggoGen_Fail(fmt.Sprintf("Expected %v with delta %v, but was NaN", expected, delta), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Expected %v with delta %v, but was NaN", expected, delta), msgAndArgs...)
 	}
 
 	dt := af - bf
 	if dt < -delta || dt > delta {
-		return debuggoGen_Fail(fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_InDeltaSlice(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_InDeltaSlice(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if expected == nil || actual == nil ||
 		reflect.TypeOf(actual).Kind() != reflect.Slice ||
 		reflect.TypeOf(expected).Kind() != reflect.Slice {
-		return debuggoGen_Fail(fmt.Sprintf("Parameters must be slice"), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Parameters must be slice"), msgAndArgs...)
 	}
 
 	actualSlice := reflect.ValueOf(actual)
 	expectedSlice := reflect.ValueOf(expected)
 
 	for i := 0; i < actualSlice.Len(); i++ {
-		result := debuggoGen_InDelta(actualSlice.Index(i).Interface(), expectedSlice.Index(i).Interface(), delta, msgAndArgs...)
+		result := debuggoGen_InDelta(t, actualSlice.Index(i).Interface(), expectedSlice.Index(i).Interface(), delta, msgAndArgs...)
 		if !result {
 			return result
 		}
@@ -1489,19 +1585,21 @@ func debuggoGen_InDeltaSlice(expected, actual interface{}, delta float64, msgAnd
 	return true
 }
 
-func debuggoGen_InDeltaMapValues(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_InDeltaMapValues(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if expected == nil || actual == nil ||
 		reflect.TypeOf(actual).Kind() != reflect.Map ||
 		reflect.TypeOf(expected).Kind() != reflect.Map {
-		return debuggoGen_Fail("Arguments must be maps", msgAndArgs...)
+		return debuggoGen_Fail(t, "Arguments must be maps", msgAndArgs...)
 	}
 
 	expectedMap := reflect.ValueOf(expected)
 	actualMap := reflect.ValueOf(actual)
 
 	if expectedMap.Len() != actualMap.Len() {
-		return debuggoGen_Fail("Arguments must have the same number of keys", msgAndArgs...)
+		return debuggoGen_Fail(t, "Arguments must have the same number of keys", msgAndArgs...)
 	}
 
 	for _, k := range expectedMap.MapKeys() {
@@ -1509,15 +1607,15 @@ func debuggoGen_InDeltaMapValues(expected, actual interface{}, delta float64, ms
 		av := actualMap.MapIndex(k)
 
 		if !ev.IsValid() {
-			return debuggoGen_Fail(fmt.Sprintf("missing key %q in expected map", k), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf("missing key %q in expected map", k), msgAndArgs...)
 		}
 
 		if !av.IsValid() {
-			return debuggoGen_Fail(fmt.Sprintf("missing key %q in actual map", k), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf("missing key %q in actual map", k), msgAndArgs...)
 		}
 
 		if !debuggoGen_InDelta(
-
+			t,
 			ev.Interface(),
 			av.Interface(),
 			delta,
@@ -1530,36 +1628,40 @@ func debuggoGen_InDeltaMapValues(expected, actual interface{}, delta float64, ms
 	return true
 }
 
-func debuggoGen_InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_InEpsilon(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if math.IsNaN(epsilon) {
-		return debuggoGen_Fail("epsilon must not be NaN")
+		return debuggoGen_Fail(t, "epsilon must not be NaN")
 	}
 	actualEpsilon, err := calcRelativeError(expected, actual)
 	if err != nil {
-		return debuggoGen_Fail(err.Error(), msgAndArgs...)
+		return debuggoGen_Fail(t, err.Error(), msgAndArgs...)
 	}
 	if actualEpsilon > epsilon {
-		return debuggoGen_Fail(fmt.Sprintf("Relative error is too high: %#v (expected)\n"+
+		return debuggoGen_Fail(t, fmt.Sprintf("Relative error is too high: %#v (expected)\n"+
 			"        < %#v (actual)", epsilon, actualEpsilon), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_InEpsilonSlice(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_InEpsilonSlice(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if expected == nil || actual == nil ||
 		reflect.TypeOf(actual).Kind() != reflect.Slice ||
 		reflect.TypeOf(expected).Kind() != reflect.Slice {
-		return debuggoGen_Fail(fmt.Sprintf("Parameters must be slice"), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Parameters must be slice"), msgAndArgs...)
 	}
 
 	actualSlice := reflect.ValueOf(actual)
 	expectedSlice := reflect.ValueOf(expected)
 
 	for i := 0; i < actualSlice.Len(); i++ {
-		result := debuggoGen_InEpsilon(actualSlice.Index(i).Interface(), expectedSlice.Index(i).Interface(), epsilon)
+		result := debuggoGen_InEpsilon(t, actualSlice.Index(i).Interface(), expectedSlice.Index(i).Interface(), epsilon)
 		if !result {
 			return result
 		}
@@ -1568,96 +1670,119 @@ func debuggoGen_InEpsilonSlice(expected, actual interface{}, epsilon float64, ms
 	return true
 }
 
-func debuggoGen_NoError(err error, msgAndArgs ...interface{}) bool {
-	if err != nil {
+/*
+	Errors
+*/
 
-		return debuggoGen_Fail(fmt.Sprintf("Received unexpected error:\n%+v", err), msgAndArgs...)
+func debuggoGen_NoError(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	if err != nil {
+		if h, ok := t.(tHelper); ok {
+			h.Helper()
+		}
+		return debuggoGen_Fail(t, fmt.Sprintf("Received unexpected error:\n%+v", err), msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_Error(err error, msgAndArgs ...interface{}) bool {
+func debuggoGen_Error(t TestingT, err error, msgAndArgs ...interface{}) bool {
 	if err == nil {
-
-		return debuggoGen_Fail("An error is expected but got nil.", msgAndArgs...)
+		if h, ok := t.(tHelper); ok {
+			h.Helper()
+		}
+		return debuggoGen_Fail(t, "An error is expected but got nil.", msgAndArgs...)
 	}
 
 	return true
 }
 
-func debuggoGen_EqualError(theError error, errString string, msgAndArgs ...interface{}) bool {
-
-	if !debuggoGen_Error(theError, msgAndArgs...) {
+func debuggoGen_EqualError(t TestingT, theError error, errString string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !debuggoGen_Error(t, theError, msgAndArgs...) {
 		return false
 	}
 	expected := errString
 	actual := theError.Error()
-
+	// don't need to use deep equals here, we know they are both strings
 	if expected != actual {
-		return debuggoGen_Fail(fmt.Sprintf("Error message not equal:\n"+
+		return debuggoGen_Fail(t, fmt.Sprintf("Error message not equal:\n"+
 			"expected: %q\n"+
 			"actual  : %q", expected, actual), msgAndArgs...)
 	}
 	return true
 }
 
-func debuggoGen_Regexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+func debuggoGen_Regexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	match := matchRegexp(rx, str)
 
 	if !match {
-		debuggoGen_Fail(fmt.Sprintf("Expect \"%v\" to match \"%v\"", str, rx), msgAndArgs...)
+		debuggoGen_Fail(t, fmt.Sprintf("Expect \"%v\" to match \"%v\"", str, rx), msgAndArgs...)
 	}
 
 	return match
 }
 
-func debuggoGen_NotRegexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_NotRegexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	match := matchRegexp(rx, str)
 
 	if match {
-		debuggoGen_Fail(fmt.Sprintf("Expect \"%v\" to NOT match \"%v\"", str, rx), msgAndArgs...)
+		debuggoGen_Fail(t, fmt.Sprintf("Expect \"%v\" to NOT match \"%v\"", str, rx), msgAndArgs...)
 	}
 
 	return !match
 
 }
 
-func debuggoGen_Zero(i interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_Zero(t TestingT, i interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if i != nil && !reflect.DeepEqual(i, reflect.Zero(reflect.TypeOf(i)).Interface()) {
-		return debuggoGen_Fail(fmt.Sprintf("Should be zero, but was %v", i), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Should be zero, but was %v", i), msgAndArgs...)
 	}
 	return true
 }
 
-func debuggoGen_NotZero(i interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_NotZero(t TestingT, i interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if i == nil || reflect.DeepEqual(i, reflect.Zero(reflect.TypeOf(i)).Interface()) {
-		return debuggoGen_Fail(fmt.Sprintf("Should not be zero, but was %v", i), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Should not be zero, but was %v", i), msgAndArgs...)
 	}
 	return true
 }
 
-func debuggoGen_FileExists(path string, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_FileExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	info, err := os.Lstat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return debuggoGen_Fail(fmt.Sprintf("unable to find file %q", path), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf("unable to find file %q", path), msgAndArgs...)
 		}
-		return debuggoGen_Fail(fmt.Sprintf("error when running os.Lstat(%q): %s", path, err), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("error when running os.Lstat(%q): %s", path, err), msgAndArgs...)
 	}
 	if info.IsDir() {
-		return debuggoGen_Fail(fmt.Sprintf("%q is a directory", path), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%q is a directory", path), msgAndArgs...)
 	}
 	return true
 }
 
-func debuggoGen_NoFileExists(path string, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_NoFileExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	info, err := os.Lstat(path)
 	if err != nil {
 		return true
@@ -1665,26 +1790,30 @@ func debuggoGen_NoFileExists(path string, msgAndArgs ...interface{}) bool {
 	if info.IsDir() {
 		return true
 	}
-	return debuggoGen_Fail(fmt.Sprintf("file %q exists", path), msgAndArgs...)
+	return debuggoGen_Fail(t, fmt.Sprintf("file %q exists", path), msgAndArgs...)
 }
 
-func debuggoGen_DirExists(path string, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_DirExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	info, err := os.Lstat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return debuggoGen_Fail(fmt.Sprintf("unable to find file %q", path), msgAndArgs...)
+			return debuggoGen_Fail(t, fmt.Sprintf("unable to find file %q", path), msgAndArgs...)
 		}
-		return debuggoGen_Fail(fmt.Sprintf("error when running os.Lstat(%q): %s", path, err), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("error when running os.Lstat(%q): %s", path, err), msgAndArgs...)
 	}
 	if !info.IsDir() {
-		return debuggoGen_Fail(fmt.Sprintf("%q is a file", path), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("%q is a file", path), msgAndArgs...)
 	}
 	return true
 }
 
-func debuggoGen_NoDirExists(path string, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_NoDirExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	info, err := os.Lstat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -1695,40 +1824,47 @@ func debuggoGen_NoDirExists(path string, msgAndArgs ...interface{}) bool {
 	if !info.IsDir() {
 		return true
 	}
-	return debuggoGen_Fail(fmt.Sprintf("directory %q exists", path), msgAndArgs...)
+	return debuggoGen_Fail(t, fmt.Sprintf("directory %q exists", path), msgAndArgs...)
 }
 
-func debuggoGen_JSONEq(expected string, actual string, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_JSONEq(t TestingT, expected string, actual string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	var expectedJSONAsInterface, actualJSONAsInterface interface{}
 
 	if err := json.Unmarshal([]byte(expected), &expectedJSONAsInterface); err != nil {
-		return debuggoGen_Fail(fmt.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expected, err.Error()), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expected, err.Error()), msgAndArgs...)
 	}
 
 	if err := json.Unmarshal([]byte(actual), &actualJSONAsInterface); err != nil {
-		return debuggoGen_Fail(fmt.Sprintf("Input ('%s') needs to be valid json.\nJSON parsing error: '%s'", actual, err.Error()), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Input ('%s') needs to be valid json.\nJSON parsing error: '%s'", actual, err.Error()), msgAndArgs...)
 	}
 
-	return debuggoGen_Equal(expectedJSONAsInterface, actualJSONAsInterface, msgAndArgs...)
+	return debuggoGen_Equal(t, expectedJSONAsInterface, actualJSONAsInterface, msgAndArgs...)
 }
 
-func debuggoGen_YAMLEq(expected string, actual string, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_YAMLEq(t TestingT, expected string, actual string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	var expectedYAMLAsInterface, actualYAMLAsInterface interface{}
 
 	if err := yaml.Unmarshal([]byte(expected), &expectedYAMLAsInterface); err != nil {
-		return debuggoGen_Fail(fmt.Sprintf("Expected value ('%s') is not valid yaml.\nYAML parsing error: '%s'", expected, err.Error()), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Expected value ('%s') is not valid yaml.\nYAML parsing error: '%s'", expected, err.Error()), msgAndArgs...)
 	}
 
 	if err := yaml.Unmarshal([]byte(actual), &actualYAMLAsInterface); err != nil {
-		return debuggoGen_Fail(fmt.Sprintf("Input ('%s') needs to be valid yaml.\nYAML error: '%s'", actual, err.Error()), msgAndArgs...)
+		return debuggoGen_Fail(t, fmt.Sprintf("Input ('%s') needs to be valid yaml.\nYAML error: '%s'", actual, err.Error()), msgAndArgs...)
 	}
 
-	return debuggoGen_Equal(expectedYAMLAsInterface, actualYAMLAsInterface, msgAndArgs...)
+	return debuggoGen_Equal(t, expectedYAMLAsInterface, actualYAMLAsInterface, msgAndArgs...)
 }
 
-func debuggoGen_Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) bool {
+func debuggoGen_Eventually(t TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	ch := make(chan bool, 1)
 
@@ -1741,7 +1877,7 @@ func debuggoGen_Eventually(condition func() bool, waitFor time.Duration, tick ti
 	for tick := ticker.C; ; {
 		select {
 		case <-timer.C:
-			return debuggoGen_Fail("Condition never satisfied", msgAndArgs...)
+			return debuggoGen_Fail(t, "Condition never satisfied", msgAndArgs...)
 		case <-tick:
 			tick = nil
 			go func() { ch <- condition() }()
@@ -1754,7 +1890,10 @@ func debuggoGen_Eventually(condition func() bool, waitFor time.Duration, tick ti
 	}
 }
 
-func debuggoGen_Never(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) bool {
+func debuggoGen_Never(t TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
 	ch := make(chan bool, 1)
 
@@ -1773,15 +1912,17 @@ func debuggoGen_Never(condition func() bool, waitFor time.Duration, tick time.Du
 			go func() { ch <- condition() }()
 		case v := <-ch:
 			if v {
-				return debuggoGen_Fail("Condition satisfied", msgAndArgs...)
+				return debuggoGen_Fail(t, "Condition satisfied", msgAndArgs...)
 			}
 			tick = ticker.C
 		}
 	}
 }
 
-func debuggoGen_ErrorIs(err, target error, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_ErrorIs(t TestingT, err, target error, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if errors.Is(err, target) {
 		return true
 	}
@@ -1793,14 +1934,16 @@ func debuggoGen_ErrorIs(err, target error, msgAndArgs ...interface{}) bool {
 
 	chain := buildErrorChainString(err)
 
-	return debuggoGen_Fail(fmt.Sprintf("Target error should be in err chain:\n"+
+	return debuggoGen_Fail(t, fmt.Sprintf("Target error should be in err chain:\n"+
 		"expected: %q\n"+
 		"in chain: %s", expectedText, chain,
 	), msgAndArgs...)
 }
 
-func debuggoGen_NotErrorIs(err, target error, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_NotErrorIs(t TestingT, err, target error, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if !errors.Is(err, target) {
 		return true
 	}
@@ -1812,21 +1955,23 @@ func debuggoGen_NotErrorIs(err, target error, msgAndArgs ...interface{}) bool {
 
 	chain := buildErrorChainString(err)
 
-	return debuggoGen_Fail(fmt.Sprintf("Target error should not be in err chain:\n"+
+	return debuggoGen_Fail(t, fmt.Sprintf("Target error should not be in err chain:\n"+
 		"found: %q\n"+
 		"in chain: %s", expectedText, chain,
 	), msgAndArgs...)
 }
 
-func debuggoGen_ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_ErrorAs(t TestingT, err error, target interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if errors.As(err, target) {
 		return true
 	}
 
 	chain := buildErrorChainString(err)
 
-	return debuggoGen_Fail(fmt.Sprintf("Should be in error chain:\n"+
+	return debuggoGen_Fail(t, fmt.Sprintf("Should be in error chain:\n"+
 		"expected: %q\n"+
 		"in chain: %s", target, chain,
 	), msgAndArgs...)
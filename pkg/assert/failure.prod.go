@@ -0,0 +1,67 @@
+//go:build !debuggo_assert && !assert && !debuggo_dev && !debuggo_staging
+// +build !debuggo_assert,!assert,!debuggo_dev,!debuggo_staging
+
+package assert
+
+import (
+	"log"
+)
+
+// Failure describes a failed assertion. It's the panic payload of the
+// PanicHandler.
+type Failure struct {
+	// Assertion is the name of the failed assertion (e.g. "Equal").
+	Assertion string
+	// CallSite is the file:line of the assertion call.
+	CallSite string
+	// Trace is the file:line of each stack frame leading to the assertion
+	// call, see CallerInfo.
+	Trace []string
+	// Expected and Actual are the compared values of the assertions that
+	// compare an expected and an actual value (e.g. Equal), nil otherwise.
+	Expected interface{}
+	Actual   interface{}
+	// Diff is the unified diff of the expected and actual values, if any.
+	Diff string
+	// Description describes why the assertion failed.
+	Description string
+	// Message is the user message of the assertion.
+	Message string
+	// Prefix is the message prefix of the Assertions object that reported
+	// the failure.
+	Prefix string
+}
+
+// Error implements the error interface, it returns the failure description
+// laid out as testify does, preceded by a line with the prefix if it's set.
+func (f *Failure) Error() string { return "" }
+
+// FailureHandler handles the failures reported by the assertions.
+type FailureHandler func(_ *Failure)
+
+// SetFailureHandler sets the failure handler of the package-level assertions.
+// A nil handler restores the default PanicHandler.
+func SetFailureHandler(_ FailureHandler) {}
+
+// PanicHandler panics with the failure, it's the default failure handler.
+func PanicHandler(_ *Failure) {}
+
+// LogHandler returns a FailureHandler that logs the failures using the given
+// logger and continue the execution. The standard logger is used if logger is
+// nil.
+func LogHandler(_ *log.Logger) FailureHandler { return *new(FailureHandler) }
+
+// SetExitFunc sets the function called by the ExitHandler handlers to exit the
+// program, os.Exit by default. The assertion returns if it returns, so the
+// code using an ExitHandler can be tested.
+func SetExitFunc(_ func(code int)) {}
+
+// ExitHandler returns a FailureHandler that logs the failures using the given
+// logger and then terminates the program with the given status code, see
+// SetExitFunc. The standard logger is used if logger is nil.
+func ExitHandler(_ *log.Logger, _ int) FailureHandler { return *new(FailureHandler) }
+
+// ChanHandler returns a FailureHandler that sends the failures to the given
+// channel. Assertions block until the failure is received if the channel is
+// full.
+func ChanHandler(_ chan<- *Failure) FailureHandler { return *new(FailureHandler) }
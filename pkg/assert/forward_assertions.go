@@ -0,0 +1,110 @@
+//go:build debuggo_assert || assert || debuggo_dev || debuggo_staging
+// +build debuggo_assert assert debuggo_dev debuggo_staging
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Assertions provides assertion methods. Failures are reported using the
+// message prefix and the failure handler of the object.
+type Assertions struct {
+	prefix  string
+	mu      sync.Mutex
+	handler FailureHandler
+}
+
+// Option configure an Assertions object.
+type Option func(*Assertions)
+
+// WithPrefix sets the prefix of the failure messages.
+func WithPrefix(prefix string) Option {
+	return func(a *Assertions) {
+		a.prefix = prefix
+	}
+}
+
+// WithFailureHandler sets the failure handler of the object, PanicHandler is
+// used by default.
+func WithFailureHandler(handler FailureHandler) Option {
+	return func(a *Assertions) {
+		a.handler = handler
+	}
+}
+
+// New makes a new Assertions object configured with the given options.
+func New(options ...Option) *Assertions {
+	a := &Assertions{}
+	for _, option := range options {
+		option(a)
+	}
+
+	return a
+}
+
+// std reports the failures of the package-level assertions.
+var std = New()
+
+// SetFailureHandler sets the failure handler of the object. A nil handler
+// restores the default PanicHandler.
+func (a *Assertions) SetFailureHandler(handler FailureHandler) {
+	a.setFailureHandler(handler)
+}
+
+func (a *Assertions) setFailureHandler(handler FailureHandler) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.handler = handler
+}
+
+func (a *Assertions) failureHandler() FailureHandler {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.handler == nil {
+		return PanicHandler
+	}
+
+	return a.handler
+}
+
+// assertionCall is the TestingT of an assertion call, it reports the failures
+// of the assertion to its Assertions object.
+type assertionCall struct {
+	assertions *Assertions
+	name       string
+	expected   interface{}
+	actual     interface{}
+}
+
+// newCall returns the TestingT of a call to the given assertion.
+func (a *Assertions) newCall(name string, expected, actual interface{}) *assertionCall {
+	return &assertionCall{
+		assertions: a,
+		name:       name,
+		expected:   expected,
+		actual:     actual,
+	}
+}
+
+// Errorf reports a failure described by the given format and arguments.
+func (c *assertionCall) Errorf(format string, args ...interface{}) {
+	c.fail(fmt.Sprintf(format, args...), "")
+}
+
+func (c *assertionCall) fail(description, message string) {
+	c.assertions.failureHandler()(&Failure{
+		Assertion:   c.name,
+		CallSite:    callSite(),
+		Trace:       CallerInfo(),
+		Expected:    c.expected,
+		Actual:      c.actual,
+		Diff:        strings.TrimPrefix(diff(c.expected, c.actual), "\n\nDiff:\n"),
+		Description: description,
+		Message:     message,
+		Prefix:      c.assertions.prefix,
+	})
+}
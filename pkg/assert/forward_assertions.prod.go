@@ -0,0 +1,26 @@
+//go:build !debuggo_assert && !assert && !debuggo_dev && !debuggo_staging
+// +build !debuggo_assert,!assert,!debuggo_dev,!debuggo_staging
+
+package assert
+
+// Assertions provides assertion methods. Failures are reported using the
+// message prefix and the failure handler of the object.
+type Assertions struct {
+}
+
+// Option configure an Assertions object.
+type Option func(*Assertions)
+
+// WithPrefix sets the prefix of the failure messages.
+func WithPrefix(_ string) Option { return *new(Option) }
+
+// WithFailureHandler sets the failure handler of the object, PanicHandler is
+// used by default.
+func WithFailureHandler(_ FailureHandler) Option { return *new(Option) }
+
+// New makes a new Assertions object configured with the given options.
+func New(_ ...Option) *Assertions { return nil }
+
+// SetFailureHandler sets the failure handler of the object. A nil handler
+// restores the default PanicHandler.
+func (a *Assertions) SetFailureHandler(_ FailureHandler) {}
@@ -1,4 +1,5 @@
-// +build assert
+//go:build debuggo_assert || assert || debuggo_dev || debuggo_staging
+// +build debuggo_assert assert debuggo_dev debuggo_staging
 
 package assert
 
@@ -25,121 +26,129 @@ func httpCode(handler http.HandlerFunc, method, url string, values url.Values) (
 
 // HTTPSuccess asserts that a specified handler returns a success status code.
 //
-//  assert.HTTPSuccess(t, myHandler, "POST", "http://www.google.com", nil)
+//	assert.HTTPSuccess(t, myHandler, "POST", "http://www.google.com", nil)
 //
 // Returns whether the assertion was successful (true) or not (false).
-func HTTPSuccess(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) {
-	debuggoGen_HTTPSuccess(handler, method, url, values, msgAndArgs)
+func HTTPSuccess(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
+	return debuggoGen_HTTPSuccess(std.newCall("HTTPSuccess", nil, nil), handler, method, url, values, msgAndArgs...)
 }
 
 // HTTPRedirect asserts that a specified handler returns a redirect status code.
 //
-//  assert.HTTPRedirect(t, myHandler, "GET", "/a/b/c", url.Values{"a": []string{"b", "c"}}
+//	assert.HTTPRedirect(t, myHandler, "GET", "/a/b/c", url.Values{"a": []string{"b", "c"}}
 //
 // Returns whether the assertion was successful (true) or not (false).
-func HTTPRedirect(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) {
-	debuggoGen_HTTPRedirect(handler, method, url, values, msgAndArgs)
+func HTTPRedirect(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
+	return debuggoGen_HTTPRedirect(std.newCall("HTTPRedirect", nil, nil), handler, method, url, values, msgAndArgs...)
 }
 
 // HTTPError asserts that a specified handler returns an error status code.
 //
-//  assert.HTTPError(t, myHandler, "POST", "/a/b/c", url.Values{"a": []string{"b", "c"}}
+//	assert.HTTPError(t, myHandler, "POST", "/a/b/c", url.Values{"a": []string{"b", "c"}}
 //
 // Returns whether the assertion was successful (true) or not (false).
-func HTTPError(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) {
-	debuggoGen_HTTPError(handler, method, url, values, msgAndArgs)
+func HTTPError(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
+	return debuggoGen_HTTPError(std.newCall("HTTPError", nil, nil), handler, method, url, values, msgAndArgs...)
 }
 
 // HTTPStatusCode asserts that a specified handler returns a specified status code.
 //
-//  assert.HTTPStatusCode(t, myHandler, "GET", "/notImplemented", nil, 501)
+//	assert.HTTPStatusCode(t, myHandler, "GET", "/notImplemented", nil, 501)
 //
 // Returns whether the assertion was successful (true) or not (false).
-func HTTPStatusCode(handler http.HandlerFunc, method, url string, values url.Values, statuscode int, msgAndArgs ...interface{}) {
-	debuggoGen_HTTPStatusCode(handler, method, url, values, statuscode, msgAndArgs)
+func HTTPStatusCode(handler http.HandlerFunc, method, url string, values url.Values, statuscode int, msgAndArgs ...interface{}) bool {
+	return debuggoGen_HTTPStatusCode(std.newCall("HTTPStatusCode", nil, nil), handler, method, url, values, statuscode, msgAndArgs...)
 }
 
 // HTTPBody is a helper that returns HTTP body of the response. It returns
 // empty string if building a new request fails.
-func HTTPBody(handler http.HandlerFunc, method, url string, values url.Values) {
-	debuggoGen_HTTPBody(handler, method, url, values)
+func HTTPBody(handler http.HandlerFunc, method, url string, values url.Values) string {
+	return debuggoGen_HTTPBody(handler, method, url, values)
 }
 
 // HTTPBodyContains asserts that a specified handler returns a
 // body that contains a string.
 //
-//  assert.HTTPBodyContains(t, myHandler, "GET", "www.google.com", nil, "I'm Feeling Lucky")
+//	assert.HTTPBodyContains(t, myHandler, "GET", "www.google.com", nil, "I'm Feeling Lucky")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func HTTPBodyContains(handler http.HandlerFunc, method, url string, values url.Values, str interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_HTTPBodyContains(handler, method, url, values, str, msgAndArgs)
+func HTTPBodyContains(handler http.HandlerFunc, method, url string, values url.Values, str interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_HTTPBodyContains(std.newCall("HTTPBodyContains", nil, nil), handler, method, url, values, str, msgAndArgs...)
 }
 
 // HTTPBodyNotContains asserts that a specified handler returns a
 // body that does not contain a string.
 //
-//  assert.HTTPBodyNotContains(t, myHandler, "GET", "www.google.com", nil, "I'm Feeling Lucky")
+//	assert.HTTPBodyNotContains(t, myHandler, "GET", "www.google.com", nil, "I'm Feeling Lucky")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func HTTPBodyNotContains(handler http.HandlerFunc, method, url string, values url.Values, str interface{}, msgAndArgs ...interface{}) {
-	debuggoGen_HTTPBodyNotContains(handler, method, url, values, str, msgAndArgs)
+func HTTPBodyNotContains(handler http.HandlerFunc, method, url string, values url.Values, str interface{}, msgAndArgs ...interface{}) bool {
+	return debuggoGen_HTTPBodyNotContains(std.newCall("HTTPBodyNotContains", nil, nil), handler, method, url, values, str, msgAndArgs...)
 }
 
-func debuggoGen_HTTPSuccess(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_HTTPSuccess(t TestingT, handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	code, err := httpCode(handler, method, url, values)
 	if err != nil {
-		debuggoGen_Fail(fmt.Sprintf("Failed to build test request, got error: %s", err))
+		debuggoGen_Fail(t, fmt.Sprintf("Failed to build test request, got error: %s", err))
 	}
 
 	isSuccessCode := code >= http.StatusOK && code <= http.StatusPartialContent
 	if !isSuccessCode {
-		debuggoGen_Fail(fmt.Sprintf("Expected HTTP success status code for %q but received %d", url+"?"+values.Encode(), code))
+		debuggoGen_Fail(t, fmt.Sprintf("Expected HTTP success status code for %q but received %d", url+"?"+values.Encode(), code))
 	}
 
 	return isSuccessCode
 }
 
-func debuggoGen_HTTPRedirect(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_HTTPRedirect(t TestingT, handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	code, err := httpCode(handler, method, url, values)
 	if err != nil {
-		debuggoGen_Fail(fmt.Sprintf("Failed to build test request, got error: %s", err))
+		debuggoGen_Fail(t, fmt.Sprintf("Failed to build test request, got error: %s", err))
 	}
 
 	isRedirectCode := code >= http.StatusMultipleChoices && code <= http.StatusTemporaryRedirect
 	if !isRedirectCode {
-		debuggoGen_Fail(fmt.Sprintf("Expected HTTP redirect status code for %q but received %d", url+"?"+values.Encode(), code))
+		debuggoGen_Fail(t, fmt.Sprintf("Expected HTTP redirect status code for %q but received %d", url+"?"+values.Encode(), code))
 	}
 
 	return isRedirectCode
 }
 
-func debuggoGen_HTTPError(handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_HTTPError(t TestingT, handler http.HandlerFunc, method, url string, values url.Values, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	code, err := httpCode(handler, method, url, values)
 	if err != nil {
-		debuggoGen_Fail(fmt.Sprintf("Failed to build test request, got error: %s", err))
+		debuggoGen_Fail(t, fmt.Sprintf("Failed to build test request, got error: %s", err))
 	}
 
 	isErrorCode := code >= http.StatusBadRequest
 	if !isErrorCode {
-		debuggoGen_Fail(fmt.Sprintf("Expected HTTP error status code for %q but received %d", url+"?"+values.Encode(), code))
+		debuggoGen_Fail(t, fmt.Sprintf("Expected HTTP error status code for %q but received %d", url+"?"+values.Encode(), code))
 	}
 
 	return isErrorCode
 }
 
-func debuggoGen_HTTPStatusCode(handler http.HandlerFunc, method, url string, values url.Values, statuscode int, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_HTTPStatusCode(t TestingT, handler http.HandlerFunc, method, url string, values url.Values, statuscode int, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	code, err := httpCode(handler, method, url, values)
 	if err != nil {
-		debuggoGen_Fail(fmt.Sprintf("Failed to build test request, got error: %s", err))
+		debuggoGen_Fail(t, fmt.Sprintf("Failed to build test request, got error: %s", err))
 	}
 
 	successful := code == statuscode
 	if !successful {
-		debuggoGen_Fail(fmt.Sprintf("Expected HTTP status code %d for %q but received %d", statuscode, url+"?"+values.Encode(), code))
+		debuggoGen_Fail(t, fmt.Sprintf("Expected HTTP status code %d for %q but received %d", statuscode, url+"?"+values.Encode(), code))
 	}
 
 	return successful
@@ -155,25 +164,29 @@ func debuggoGen_HTTPBody(handler http.HandlerFunc, method, url string, values ur
 	return w.Body.String()
 }
 
-func debuggoGen_HTTPBodyContains(handler http.HandlerFunc, method, url string, values url.Values, str interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_HTTPBodyContains(t TestingT, handler http.HandlerFunc, method, url string, values url.Values, str interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	body := debuggoGen_HTTPBody(handler, method, url, values)
 
 	contains := strings.Contains(body, fmt.Sprint(str))
 	if !contains {
-		debuggoGen_Fail(fmt.Sprintf("Expected response body for \"%s\" to contain \"%s\" but found \"%s\"", url+"?"+values.Encode(), str, body))
+		debuggoGen_Fail(t, fmt.Sprintf("Expected response body for \"%s\" to contain \"%v\" but found \"%s\"", url+"?"+values.Encode(), str, body))
 	}
 
 	return contains
 }
 
-func debuggoGen_HTTPBodyNotContains(handler http.HandlerFunc, method, url string, values url.Values, str interface{}, msgAndArgs ...interface{}) bool {
-
+func debuggoGen_HTTPBodyNotContains(t TestingT, handler http.HandlerFunc, method, url string, values url.Values, str interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	body := debuggoGen_HTTPBody(handler, method, url, values)
 
 	contains := strings.Contains(body, fmt.Sprint(str))
 	if contains {
-		debuggoGen_Fail(fmt.Sprintf("Expected response body for \"%s\" to NOT contain \"%s\" but found \"%s\"", url+"?"+values.Encode(), str, body))
+		debuggoGen_Fail(t, fmt.Sprintf("Expected response body for \"%s\" to NOT contain \"%v\" but found \"%s\"", url+"?"+values.Encode(), str, body))
 	}
 
 	return !contains
@@ -1,63 +1,64 @@
-// +build !assert
+//go:build !debuggo_assert && !assert && !debuggo_dev && !debuggo_staging
+// +build !debuggo_assert,!assert,!debuggo_dev,!debuggo_staging
 
 package assert
 
 import (
 	"net/http"
+
 	"net/url"
 )
 
-func HTTPSuccess( // HTTPSuccess asserts that a specified handler returns a success status code.
-	//
-	//  assert.HTTPSuccess(t, myHandler, "POST", "http://www.google.com", nil)
-	//
-	// Returns whether the assertion was successful (true) or not (false).
-	_ http.HandlerFunc, _, _ string, _ url.Values, _ ...interface{}) {
-}
+// HTTPSuccess asserts that a specified handler returns a success status code.
+//
+//	assert.HTTPSuccess(t, myHandler, "POST", "http://www.google.com", nil)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPSuccess(_ http.HandlerFunc, _, _ string, _ url.Values, _ ...interface{}) bool { return true }
 
-func HTTPRedirect( // HTTPRedirect asserts that a specified handler returns a redirect status code.
-	//
-	//  assert.HTTPRedirect(t, myHandler, "GET", "/a/b/c", url.Values{"a": []string{"b", "c"}}
-	//
-	// Returns whether the assertion was successful (true) or not (false).
-	_ http.HandlerFunc, _, _ string, _ url.Values, _ ...interface{}) {
-}
+// HTTPRedirect asserts that a specified handler returns a redirect status code.
+//
+//	assert.HTTPRedirect(t, myHandler, "GET", "/a/b/c", url.Values{"a": []string{"b", "c"}}
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPRedirect(_ http.HandlerFunc, _, _ string, _ url.Values, _ ...interface{}) bool { return true }
 
-func HTTPError( // HTTPError asserts that a specified handler returns an error status code.
-	//
-	//  assert.HTTPError(t, myHandler, "POST", "/a/b/c", url.Values{"a": []string{"b", "c"}}
-	//
-	// Returns whether the assertion was successful (true) or not (false).
-	_ http.HandlerFunc, _, _ string, _ url.Values, _ ...interface{}) {
-}
+// HTTPError asserts that a specified handler returns an error status code.
+//
+//	assert.HTTPError(t, myHandler, "POST", "/a/b/c", url.Values{"a": []string{"b", "c"}}
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPError(_ http.HandlerFunc, _, _ string, _ url.Values, _ ...interface{}) bool { return true }
 
-func HTTPStatusCode( // HTTPStatusCode asserts that a specified handler returns a specified status code.
-	//
-	//  assert.HTTPStatusCode(t, myHandler, "GET", "/notImplemented", nil, 501)
-	//
-	// Returns whether the assertion was successful (true) or not (false).
-	_ http.HandlerFunc, _, _ string, _ url.Values, _ int, _ ...interface{}) {
+// HTTPStatusCode asserts that a specified handler returns a specified status code.
+//
+//	assert.HTTPStatusCode(t, myHandler, "GET", "/notImplemented", nil, 501)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPStatusCode(_ http.HandlerFunc, _, _ string, _ url.Values, _ int, _ ...interface{}) bool {
+	return true
 }
 
-func HTTPBody( // HTTPBody is a helper that returns HTTP body of the response. It returns
-	// empty string if building a new request fails.
-	_ http.HandlerFunc, _, _ string, _ url.Values) {
-}
+// HTTPBody is a helper that returns HTTP body of the response. It returns
+// empty string if building a new request fails.
+func HTTPBody(_ http.HandlerFunc, _, _ string, _ url.Values) string { return "" }
 
-func HTTPBodyContains( // HTTPBodyContains asserts that a specified handler returns a
-	// body that contains a string.
-	//
-	//  assert.HTTPBodyContains(t, myHandler, "GET", "www.google.com", nil, "I'm Feeling Lucky")
-	//
-	// Returns whether the assertion was successful (true) or not (false).
-	_ http.HandlerFunc, _, _ string, _ url.Values, _ interface{}, _ ...interface{}) {
+// HTTPBodyContains asserts that a specified handler returns a
+// body that contains a string.
+//
+//	assert.HTTPBodyContains(t, myHandler, "GET", "www.google.com", nil, "I'm Feeling Lucky")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPBodyContains(_ http.HandlerFunc, _, _ string, _ url.Values, _ interface{}, _ ...interface{}) bool {
+	return true
 }
 
-func HTTPBodyNotContains( // HTTPBodyNotContains asserts that a specified handler returns a
-	// body that does not contain a string.
-	//
-	//  assert.HTTPBodyNotContains(t, myHandler, "GET", "www.google.com", nil, "I'm Feeling Lucky")
-	//
-	// Returns whether the assertion was successful (true) or not (false).
-	_ http.HandlerFunc, _, _ string, _ url.Values, _ interface{}, _ ...interface{}) {
+// HTTPBodyNotContains asserts that a specified handler returns a
+// body that does not contain a string.
+//
+//	assert.HTTPBodyNotContains(t, myHandler, "GET", "www.google.com", nil, "I'm Feeling Lucky")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPBodyNotContains(_ http.HandlerFunc, _, _ string, _ url.Values, _ interface{}, _ ...interface{}) bool {
+	return true
 }
@@ -0,0 +1,191 @@
+package log
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy defines what an AsyncWriter does with an entry written when
+// its queue is full.
+type OverflowPolicy int
+
+const (
+	// Block waits until the queue has room for the entry.
+	Block OverflowPolicy = iota
+	// DropNewest discards the entry.
+	DropNewest
+	// DropOldest discards the oldest queued entry to make room for the entry.
+	DropOldest
+)
+
+// ErrClosed is returned by the writes to a closed AsyncWriter.
+var ErrClosed = errors.New("log: write to closed AsyncWriter")
+
+// AsyncWriter is an output that queues the entries and writes them from a
+// background goroutine, the logging functions don't wait for the writes:
+//
+//	out := log.NewAsyncWriter(os.Stderr, 1024, log.DropOldest)
+//	defer out.Close()
+//	log.SetOutput(out)
+//
+// It's a Flusher, the queued entries are written before the Fatal functions
+// exit and before the Panic functions panic.
+type AsyncWriter struct {
+	w      io.Writer
+	policy OverflowPolicy
+	queue  chan []byte
+	done   chan struct{}
+
+	// closeMu prevents the writes from sending to the closed queue.
+	closeMu sync.RWMutex
+	closed  bool
+
+	mu      sync.Mutex
+	idle    *sync.Cond
+	pending int
+	err     error
+
+	dropped atomic.Uint64
+}
+
+// NewAsyncWriter returns an AsyncWriter writing to w with a queue of the given
+// size, the policy sets what happens to the entries written when it's full. A
+// size below 1 is replaced by 1.
+func NewAsyncWriter(w io.Writer, size int, policy OverflowPolicy) *AsyncWriter {
+	if size < 1 {
+		size = 1
+	}
+
+	a := &AsyncWriter{
+		w:      w,
+		policy: policy,
+		queue:  make(chan []byte, size),
+		done:   make(chan struct{}),
+	}
+	a.idle = sync.NewCond(&a.mu)
+
+	go a.run()
+
+	return a
+}
+
+// Write queues a copy of p, it never returns the errors of the underlying
+// writer (see Flush). A dropped entry isn't an error.
+func (a *AsyncWriter) Write(p []byte) (int, error) {
+	a.closeMu.RLock()
+	defer a.closeMu.RUnlock()
+
+	if a.closed {
+		return 0, ErrClosed
+	}
+
+	entry := append([]byte(nil), p...)
+
+	a.mu.Lock()
+	a.pending++
+	a.mu.Unlock()
+
+	switch a.policy {
+	case DropNewest:
+		select {
+		case a.queue <- entry:
+		default:
+			a.drop()
+		}
+
+	case DropOldest:
+		for sent := false; !sent; {
+			select {
+			case a.queue <- entry:
+				sent = true
+			default:
+				select {
+				case <-a.queue:
+					a.drop()
+				default:
+				}
+			}
+		}
+
+	default:
+		a.queue <- entry
+	}
+
+	return len(p), nil
+}
+
+// Dropped returns the number of entries dropped because the queue was full.
+func (a *AsyncWriter) Dropped() uint64 {
+	return a.dropped.Load()
+}
+
+// Flush waits until the queued entries are written and flushes the underlying
+// writer if it's a Flusher. It returns the first error of the underlying
+// writer since the previous call to Flush.
+func (a *AsyncWriter) Flush() error {
+	a.mu.Lock()
+	for a.pending != 0 {
+		a.idle.Wait()
+	}
+	err := a.err
+	a.err = nil
+	a.mu.Unlock()
+
+	if flusher, isFlusher := a.w.(Flusher); isFlusher {
+		if flushErr := flusher.Flush(); err == nil {
+			err = flushErr
+		}
+	}
+
+	return err
+}
+
+// Close flushes the writer and stops its background goroutine, the later
+// writes return ErrClosed.
+func (a *AsyncWriter) Close() error {
+	a.closeMu.Lock()
+	if a.closed {
+		a.closeMu.Unlock()
+		return nil
+	}
+	a.closed = true
+	close(a.queue)
+	a.closeMu.Unlock()
+
+	<-a.done
+	return a.Flush()
+}
+
+func (a *AsyncWriter) run() {
+	defer close(a.done)
+
+	for entry := range a.queue {
+		_, err := a.w.Write(entry)
+
+		a.mu.Lock()
+		if a.err == nil {
+			a.err = err
+		}
+		a.finish()
+		a.mu.Unlock()
+	}
+}
+
+// drop counts a dropped entry.
+func (a *AsyncWriter) drop() {
+	a.dropped.Add(1)
+
+	a.mu.Lock()
+	a.finish()
+	a.mu.Unlock()
+}
+
+// finish marks a queued entry as written or dropped, a.mu must be held.
+func (a *AsyncWriter) finish() {
+	a.pending--
+	if a.pending == 0 {
+		a.idle.Broadcast()
+	}
+}
@@ -0,0 +1,280 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Entry is a log entry, as given to the encoders.
+type Entry struct {
+	// Time is the time of the entry, it's zero if none of the time flags of the
+	// logger is set.
+	Time time.Time
+	// Flags are the flags of the logger.
+	Flags int
+	// Prefix is the prefix of the logger.
+	Prefix string
+	// Caller is the file and line of the call ("file.go:12"), it's empty if none
+	// of the file flags of the logger is set.
+	Caller string
+	// Function is the name of the calling function ("main.(*Server).serve"),
+	// it's empty if the Lfunction flag of the logger isn't set.
+	Function string
+	// Level is the name of the level of the entry and Label its label, colored
+	// if the colors are enabled.
+	Level, Label string
+	// Name is the name of the logger, see Logger.Named.
+	Name string
+	// Layout is the header layout of the logger.
+	Layout []HeaderField
+	// Message is the message of the entry, without a trailing newline.
+	Message string
+	// Fields are the key/value pairs of the entry, the pairs of the parent
+	// loggers come first.
+	Fields []Field
+}
+
+// Field is a key/value pair of an entry.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// badKey is the key of a value without a key.
+const badKey = "!BADKEY"
+
+// fields returns the fields of the given key/value pairs. A key that isn't a
+// string is formatted with fmt.Sprint, and a trailing value without a key gets
+// the "!BADKEY" key.
+func fields(kv []interface{}) []Field {
+	if len(kv) == 0 {
+		return nil
+	}
+
+	result := make([]Field, 0, (len(kv)+1)/2)
+	for i := 0; i < len(kv); i += 2 {
+		if i+1 == len(kv) {
+			result = append(result, Field{Key: badKey, Value: kv[i]})
+			break
+		}
+
+		key, isString := kv[i].(string)
+		if !isString {
+			key = fmt.Sprint(kv[i])
+		}
+		result = append(result, Field{Key: key, Value: kv[i+1]})
+	}
+
+	return result
+}
+
+// Encoder encodes the entries of a Logger.
+type Encoder interface {
+	// Encode appends the given entry, terminated by a newline, to buf and
+	// returns the extended buffer. Nothing is written to the output of the
+	// logger if the buffer is empty, an encoder that hands the entries to
+	// another logging library returns it as is.
+	Encode(buf []byte, entry *Entry) []byte
+}
+
+// TextEncoder is the default Encoder, it writes the header of the entry as
+// set by the layout of the logger, followed by the message and the fields in
+// the logfmt format:
+//
+//	2020/10/24 10:19:59 [INFO] request served path=/users status=200
+type TextEncoder struct{}
+
+// Encode implements the Encoder interface.
+func (TextEncoder) Encode(buf []byte, entry *Entry) []byte {
+	for _, field := range entry.Layout {
+		switch field {
+		case HeaderPrefix:
+			if entry.Flags&log.Lmsgprefix == 0 {
+				buf = append(buf, entry.Prefix...)
+			}
+
+		case HeaderTime:
+			buf = appendTime(buf, entry.Time, entry.Flags)
+
+		case HeaderCaller:
+			if entry.Caller != "" {
+				buf = append(buf, entry.Caller...)
+				buf = append(buf, ": "...)
+			}
+			if entry.Function != "" {
+				buf = append(buf, entry.Function...)
+				buf = append(buf, ": "...)
+			}
+
+		case HeaderLevel:
+			buf = append(buf, entry.Label...)
+			buf = append(buf, ' ')
+
+		case HeaderName:
+			if entry.Name != "" {
+				buf = append(buf, entry.Name...)
+				buf = append(buf, ": "...)
+			}
+		}
+	}
+
+	if entry.Flags&log.Lmsgprefix != 0 {
+		buf = append(buf, entry.Prefix...)
+	}
+
+	buf = append(buf, entry.Message...)
+	for _, field := range entry.Fields {
+		buf = append(buf, ' ')
+		buf = appendLogfmt(buf, field.Key, field.Value)
+	}
+
+	return append(buf, '\n')
+}
+
+// appendTime appends the time as formatted by the standard library logger.
+func appendTime(buf []byte, t time.Time, flags int) []byte {
+	if flags&log.Ldate != 0 {
+		buf = t.AppendFormat(buf, "2006/01/02 ")
+	}
+
+	if flags&(log.Ltime|log.Lmicroseconds) != 0 {
+		buf = t.AppendFormat(buf, "15:04:05")
+		if flags&log.Lmicroseconds != 0 {
+			buf = t.AppendFormat(buf, ".000000")
+		}
+		buf = append(buf, ' ')
+	}
+
+	return buf
+}
+
+// JSONEncoder is an Encoder that writes an entry as a JSON object per line:
+//
+//	{"time":"2020-10-24T10:19:59+02:00","level":"info","msg":"request served","path":"/users","status":200}
+//
+// The time, logger, caller, func and prefix keys are omitted when they're
+// empty. Values that can't be marshalled are written as strings, errors as
+// their message.
+type JSONEncoder struct{}
+
+// Encode implements the Encoder interface.
+func (JSONEncoder) Encode(buf []byte, entry *Entry) []byte {
+	buf = append(buf, '{')
+	for i, field := range headerFields(entry) {
+		if i != 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendJSON(buf, field.Key)
+		buf = append(buf, ':')
+		buf = appendJSON(buf, field.Value)
+	}
+
+	return append(buf, '}', '\n')
+}
+
+func appendJSON(buf []byte, value interface{}) []byte {
+	if err, isError := value.(error); isError {
+		value = err.Error()
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		data, _ = json.Marshal(fmt.Sprint(value))
+	}
+
+	return append(buf, data...)
+}
+
+// LogfmtEncoder is an Encoder that writes an entry in the logfmt format:
+//
+//	time=2020-10-24T10:19:59+02:00 level=info msg="request served" path=/users status=200
+//
+// The time, logger, caller, func and prefix keys are omitted when they're
+// empty.
+type LogfmtEncoder struct{}
+
+// Encode implements the Encoder interface.
+func (LogfmtEncoder) Encode(buf []byte, entry *Entry) []byte {
+	for i, field := range headerFields(entry) {
+		if i != 0 {
+			buf = append(buf, ' ')
+		}
+		buf = appendLogfmt(buf, field.Key, field.Value)
+	}
+
+	return append(buf, '\n')
+}
+
+func appendLogfmt(buf []byte, key string, value interface{}) []byte {
+	buf = appendLogfmtValue(buf, key)
+	buf = append(buf, '=')
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case error:
+		s = v.Error()
+	default:
+		s = fmt.Sprint(v)
+	}
+
+	return appendLogfmtValue(buf, s)
+}
+
+// appendLogfmtValue appends s, quoted if it's empty or contains spaces, quotes,
+// equal signs or non printable characters.
+func appendLogfmtValue(buf []byte, s string) []byte {
+	if s == "" {
+		return append(buf, `""`...)
+	}
+
+	for _, r := range s {
+		if r == utf8.RuneError || r == '"' || r == '=' || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return strconv.AppendQuote(buf, s)
+		}
+	}
+
+	return append(buf, s...)
+}
+
+// headerFields returns the fields of the given entry prepended with the time,
+// level, logger, caller, function, prefix and message fields.
+func headerFields(entry *Entry) []Field {
+	result := make([]Field, 0, 7+len(entry.Fields))
+
+	if !entry.Time.IsZero() {
+		layout := time.RFC3339
+		if entry.Flags&log.Lmicroseconds != 0 {
+			layout = time.RFC3339Nano
+		}
+		result = append(result, Field{Key: "time", Value: entry.Time.Format(layout)})
+	}
+
+	result = append(result, Field{Key: "level", Value: entry.Level})
+
+	if entry.Name != "" {
+		result = append(result, Field{Key: "logger", Value: entry.Name})
+	}
+
+	if entry.Caller != "" {
+		result = append(result, Field{Key: "caller", Value: entry.Caller})
+	}
+
+	if entry.Function != "" {
+		result = append(result, Field{Key: "func", Value: entry.Function})
+	}
+
+	if entry.Prefix != "" {
+		result = append(result, Field{Key: "prefix", Value: entry.Prefix})
+	}
+
+	result = append(result, Field{Key: "msg", Value: entry.Message})
+
+	return append(result, entry.Fields...)
+}
@@ -1,259 +1,304 @@
-// +build !panic,!fatal,!error,!warn,!info,!debug,!trace
+//go:build !debuggo_log_panic && !panic && !debuggo_log_fatal && !fatal && !debuggo_log_error && !error && !debuggo_log_warn && !warn && !debuggo_log_info && !info && !debuggo_staging && !debuggo_log_debug && !debug && !debuggo_dev && !debuggo_log_trace && !trace
+// +build !debuggo_log_panic,!panic,!debuggo_log_fatal,!fatal,!debuggo_log_error,!error,!debuggo_log_warn,!warn,!debuggo_log_info,!info,!debuggo_staging,!debuggo_log_debug,!debug,!debuggo_dev,!debuggo_log_trace,!trace
 
 package log
 
-import ()
+import (
+	"io"
+	"log"
+	"os"
+)
 
-// Panic level
+const (
+	// LevelEnv is the environment variable that sets the level of the loggers
+	// at init, see SetLevel.
+	LevelEnv = "DEBUGGO_LOG_LEVEL"
+	// VModuleEnv is the environment variable that sets the levels of the named
+	// loggers and of the source files at init, see SetVModule.
+	VModuleEnv = "DEBUGGO_LOG_VMODULE"
+)
 
-func Panic(args ...interface{}) {
+// levels are the levels of the package, from the least to the most verbose.
+var levels = []string{"panic", "fatal", "error", "warn", "info", "debug", "trace"}
 
+// Levels returns the levels of the package, from the least to the most
+// verbose. They're set by the configuration the package is generated from.
+func Levels() []string {
+	return append([]string(nil), levels...)
 }
 
-func Panicf(format string, args ...interface{}) {
-
-}
+// Enabled reports whether the given level is enabled by the build tags, see
+// LevelEnabled.
+func Enabled(_ string) bool { return false }
 
-func Panicln(args ...interface{}) {
+var std = New(os.Stderr, "", log.LstdFlags)
 
+// Named returns a child of the standard logger with the given name.
+func Named(name string) *Logger {
+	return std.Named(name)
 }
 
-func Panicfn(fn func() []interface{}) {
+// SetOutput sets the output destination of the standard logger.
+func SetOutput(_ io.Writer) {}
 
-}
+// SetFlags sets the output flags of the standard logger.
+func SetFlags(_ int) {}
 
-// Fatal level
+// SetPrefix sets the output prefix of the standard logger.
+func SetPrefix(_ string) {}
 
-func Fatal(args ...interface{}) {
+// SetLevel sets the most verbose level of the standard logger.
+func SetLevel(_ string) error { return nil }
 
-}
+// SetVModule sets the levels of the named loggers and of the source files with
+// a comma separated list of pattern=level rules:
+//
+//	db=trace,http/*=info,server.go=debug
+//
+// The patterns ending with ".go" match the file of the call, against as many
+// trailing path elements as the pattern has, and the others match the name of
+// the logger. The patterns use the path.Match syntax. A rule matching the file
+// takes precedence over one matching the name, and the first matching rule of
+// each kind wins. The rules override the level set by SetLevel, but they can't
+// enable the levels disabled by the build tags. An empty spec removes the
+// rules.
+func SetVModule(_ string) error { return nil }
 
-func Fatalf(format string, args ...interface{}) {
+// SetLabel sets the label of the given level of the standard logger.
+func SetLabel(_, _ string) {}
 
-}
-
-func Fatalln(args ...interface{}) {
+// SetColor sets when the level labels of the standard logger are colored.
+func SetColor(_ ColorMode) {}
 
-}
+// SetLayout sets the header layout of the standard logger.
+func SetLayout(_ ...HeaderField) {}
 
-func Fatalfn(fn func() []interface{}) {
+// SetEncoder sets the encoder of the standard logger.
+func SetEncoder(_ Encoder) {}
 
+// Flusher is implemented by the outputs that buffer their writes, such as
+// bufio.Writer. The output of a logger is flushed before the Fatal functions
+// exit and before the Panic functions panic.
+type Flusher interface {
+	Flush() error
 }
 
-// Error level
+// SetExitFunc sets the function called by the Fatal functions to exit the
+// program, os.Exit by default. The Fatal functions return if it returns, so
+// the code logging fatal entries can be tested.
+func SetExitFunc(_ func(code int)) {}
 
-func Error(args ...interface{}) {
+// SetExitCode sets the exit code of the Fatal functions, 1 by default.
+func SetExitCode(_ int) {}
 
-}
+// RegisterExitHandler adds a handler called by the Fatal functions before
+// exiting, the handlers are called in the order they were registered. A
+// handler that panics doesn't prevent the exit.
+func RegisterExitHandler(_ func()) {}
 
-func Errorf(format string, args ...interface{}) {
+// Panic level
 
-}
+func PanicEnabled() bool { return false }
 
-func Errorln(args ...interface{}) {
+func Panic(_ ...interface{}) {}
 
-}
+func Panicf(_ string, _ ...interface{}) {}
 
-func Errorfn(fn func() []interface{}) {
+func Panicln(_ ...interface{}) {}
 
-}
+func Panicfn(_ func() []interface{}) {}
 
-// Warn level
+func Panicw(_ string, _ ...interface{}) {}
 
-func Warn(args ...interface{}) {
+// Fatal level
 
-}
+func FatalEnabled() bool { return false }
 
-func Warnf(format string, args ...interface{}) {
+func Fatal(_ ...interface{}) {}
 
-}
+func Fatalf(_ string, _ ...interface{}) {}
 
-func Warnln(args ...interface{}) {
+func Fatalln(_ ...interface{}) {}
 
-}
+func Fatalfn(_ func() []interface{}) {}
 
-func Warnfn(fn func() []interface{}) {
+func Fatalw(_ string, _ ...interface{}) {}
 
-}
+// Error level
 
-// Info level
+func ErrorEnabled() bool { return false }
 
-func Info(args ...interface{}) {
+func Error(_ ...interface{}) {}
 
-}
+func Errorf(_ string, _ ...interface{}) {}
 
-func Infof(format string, args ...interface{}) {
+func Errorln(_ ...interface{}) {}
 
-}
+func Errorfn(_ func() []interface{}) {}
 
-func Infoln(args ...interface{}) {
+func Errorw(_ string, _ ...interface{}) {}
 
-}
+// Warn level
 
-func Infofn(fn func() []interface{}) {
+func WarnEnabled() bool { return false }
 
-}
+func Warn(_ ...interface{}) {}
 
-// Debug level
+func Warnf(_ string, _ ...interface{}) {}
 
-func Debug(args ...interface{}) {
+func Warnln(_ ...interface{}) {}
 
-}
+func Warnfn(_ func() []interface{}) {}
 
-func Debugf(format string, args ...interface{}) {
+func Warnw(_ string, _ ...interface{}) {}
 
-}
+// Info level
 
-func Debugln(args ...interface{}) {
+func InfoEnabled() bool { return false }
 
-}
+func Info(_ ...interface{}) {}
 
-func Debugfn(fn func() []interface{}) {
+func Infof(_ string, _ ...interface{}) {}
 
-}
+func Infoln(_ ...interface{}) {}
 
-// Trace level
+func Infofn(_ func() []interface{}) {}
 
-func Trace(args ...interface{}) {
+func Infow(_ string, _ ...interface{}) {}
 
-}
+// Debug level
 
-func Tracef(format string, args ...interface{}) {
+func DebugEnabled() bool { return false }
 
-}
+func Debug(_ ...interface{}) {}
 
-func Traceln(args ...interface{}) {
+func Debugf(_ string, _ ...interface{}) {}
 
-}
+func Debugln(_ ...interface{}) {}
 
-func Tracefn(fn func() []interface{}) {
+func Debugfn(_ func() []interface{}) {}
 
-}
+func Debugw(_ string, _ ...interface{}) {}
 
-// Logger
+// Trace level
 
-// Panic level
+func TraceEnabled() bool { return false }
 
-func (l *Logger) Panic(args ...interface{}) {
+func Trace(_ ...interface{}) {}
 
-}
+func Tracef(_ string, _ ...interface{}) {}
 
-func (l *Logger) Panicf(format string, args ...interface{}) {
+func Traceln(_ ...interface{}) {}
 
-}
+func Tracefn(_ func() []interface{}) {}
 
-func (l *Logger) Panicln(args ...interface{}) {
+func Tracew(_ string, _ ...interface{}) {}
 
-}
+// Logger
 
-func (l *Logger) Panicfn(fn func() []interface{}) {
+// SetLabel sets the label of the entries of the given level, it defaults to the
+// upper case level name in brackets (e.g. "[INFO]").
+func (l *Logger) SetLabel(_, _ string) {}
 
-}
+// SetColor sets when the level labels are colored, they're colored when the
+// output is a terminal by default.
+func (l *Logger) SetColor(_ ColorMode) {}
 
-// Fatal level
+// SetLayout sets the fields of the header of the entries, in order. The time,
+// caller and prefix fields are formatted according to the flags and the prefix
+// of the logger (see SetFlags and SetPrefix), DefaultLayout is used if no
+// field is given.
+func (l *Logger) SetLayout(_ ...HeaderField) {}
 
-func (l *Logger) Fatal(args ...interface{}) {
+// SetLevel sets the most verbose level of the logger, the entries of the more
+// verbose levels are discarded. It can't enable the levels disabled by the
+// build tags. The level of the existing children of the logger is unchanged,
+// the children created afterwards start with the new level.
+func (l *Logger) SetLevel(_ string) error { return nil }
 
-}
+// SetEncoder sets the encoder of the entries, TextEncoder is used by default.
+func (l *Logger) SetEncoder(_ Encoder) {}
 
-func (l *Logger) Fatalf(format string, args ...interface{}) {
+// Panic level
 
-}
+func (l *Logger) Panic(_ ...interface{}) {}
 
-func (l *Logger) Fatalln(args ...interface{}) {
+func (l *Logger) Panicf(_ string, _ ...interface{}) {}
 
-}
+func (l *Logger) Panicln(_ ...interface{}) {}
 
-func (l *Logger) Fatalfn(fn func() []interface{}) {
+func (l *Logger) Panicfn(_ func() []interface{}) {}
 
-}
+func (l *Logger) Panicw(_ string, _ ...interface{}) {}
 
-// Error level
+// Fatal level
 
-func (l *Logger) Error(args ...interface{}) {
+func (l *Logger) Fatal(_ ...interface{}) {}
 
-}
+func (l *Logger) Fatalf(_ string, _ ...interface{}) {}
 
-func (l *Logger) Errorf(format string, args ...interface{}) {
+func (l *Logger) Fatalln(_ ...interface{}) {}
 
-}
+func (l *Logger) Fatalfn(_ func() []interface{}) {}
 
-func (l *Logger) Errorln(args ...interface{}) {
+func (l *Logger) Fatalw(_ string, _ ...interface{}) {}
 
-}
+// Error level
 
-func (l *Logger) Errorfn(fn func() []interface{}) {
+func (l *Logger) Error(_ ...interface{}) {}
 
-}
+func (l *Logger) Errorf(_ string, _ ...interface{}) {}
 
-// Warn level
+func (l *Logger) Errorln(_ ...interface{}) {}
 
-func (l *Logger) Warn(args ...interface{}) {
+func (l *Logger) Errorfn(_ func() []interface{}) {}
 
-}
+func (l *Logger) Errorw(_ string, _ ...interface{}) {}
 
-func (l *Logger) Warnf(format string, args ...interface{}) {
+// Warn level
 
-}
+func (l *Logger) Warn(_ ...interface{}) {}
 
-func (l *Logger) Warnln(args ...interface{}) {
+func (l *Logger) Warnf(_ string, _ ...interface{}) {}
 
-}
+func (l *Logger) Warnln(_ ...interface{}) {}
 
-func (l *Logger) Warnfn(fn func() []interface{}) {
+func (l *Logger) Warnfn(_ func() []interface{}) {}
 
-}
+func (l *Logger) Warnw(_ string, _ ...interface{}) {}
 
 // Info level
 
-func (l *Logger) Info(args ...interface{}) {
-
-}
-
-func (l *Logger) Infof(format string, args ...interface{}) {
+func (l *Logger) Info(_ ...interface{}) {}
 
-}
+func (l *Logger) Infof(_ string, _ ...interface{}) {}
 
-func (l *Logger) Infoln(args ...interface{}) {
+func (l *Logger) Infoln(_ ...interface{}) {}
 
-}
+func (l *Logger) Infofn(_ func() []interface{}) {}
 
-func (l *Logger) Infofn(fn func() []interface{}) {
-
-}
+func (l *Logger) Infow(_ string, _ ...interface{}) {}
 
 // Debug level
 
-func (l *Logger) Debug(args ...interface{}) {
-
-}
-
-func (l *Logger) Debugf(format string, args ...interface{}) {
+func (l *Logger) Debug(_ ...interface{}) {}
 
-}
-
-func (l *Logger) Debugln(args ...interface{}) {
+func (l *Logger) Debugf(_ string, _ ...interface{}) {}
 
-}
+func (l *Logger) Debugln(_ ...interface{}) {}
 
-func (l *Logger) Debugfn(fn func() []interface{}) {
+func (l *Logger) Debugfn(_ func() []interface{}) {}
 
-}
+func (l *Logger) Debugw(_ string, _ ...interface{}) {}
 
 // Trace level
 
-func (l *Logger) Trace(args ...interface{}) {
+func (l *Logger) Trace(_ ...interface{}) {}
 
-}
+func (l *Logger) Tracef(_ string, _ ...interface{}) {}
 
-func (l *Logger) Tracef(format string, args ...interface{}) {
+func (l *Logger) Traceln(_ ...interface{}) {}
 
-}
+func (l *Logger) Tracefn(_ func() []interface{}) {}
 
-func (l *Logger) Traceln(args ...interface{}) {
-
-}
-
-func (l *Logger) Tracefn(fn func() []interface{}) {
-
-}
+func (l *Logger) Tracew(_ string, _ ...interface{}) {}
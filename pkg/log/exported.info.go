@@ -1,265 +1,942 @@
-// +build info
+//go:build (debuggo_log_info || info || debuggo_staging) && !debuggo_log_debug && !debug && !debuggo_dev && !debuggo_log_trace && !trace
+// +build debuggo_log_info info debuggo_staging
+// +build !debuggo_log_debug
+// +build !debug
+// +build !debuggo_dev
+// +build !debuggo_log_trace
+// +build !trace
 
 package log
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
+const (
+	// LevelEnv is the environment variable that sets the level of the loggers
+	// at init, see SetLevel.
+	LevelEnv = "DEBUGGO_LOG_LEVEL"
+	// VModuleEnv is the environment variable that sets the levels of the named
+	// loggers and of the source files at init, see SetVModule.
+	VModuleEnv = "DEBUGGO_LOG_VMODULE"
+)
+
+// levels are the levels of the package, from the least to the most verbose.
+var levels = []string{"panic", "fatal", "error", "warn", "info", "debug", "trace"}
+
+// levelEnabled maps the levels to their LevelEnabled function.
+var levelEnabled = map[string]func() bool{"panic": PanicEnabled, "fatal": FatalEnabled, "error": ErrorEnabled, "warn": WarnEnabled, "info": InfoEnabled, "debug": DebugEnabled, "trace": TraceEnabled}
+
+// Levels returns the levels of the package, from the least to the most
+// verbose. They're set by the configuration the package is generated from.
+func Levels() []string {
+	return append([]string(nil), levels...)
+}
+
+// Enabled reports whether the given level is enabled by the build tags, see
+// LevelEnabled.
+func Enabled(level string) bool {
+	enabled, isLevel := levelEnabled[strings.ToLower(level)]
+	return isLevel && enabled()
+}
+
 var std = New(os.Stderr, "", log.LstdFlags)
 
+func init() {
+	if level, isSet := os.LookupEnv(LevelEnv); isSet {
+		limit, err := levelLimit(level)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "log: invalid %v: %v\n", LevelEnv, err)
+		} else {
+			defaultLimit = limit
+			std.limit.Store(limit)
+		}
+	}
+
+	if spec, isSet := os.LookupEnv(VModuleEnv); isSet {
+		if err := SetVModule(spec); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "log: invalid %v: %v\n", VModuleEnv, err)
+		}
+	}
+}
+
+// Named returns a child of the standard logger with the given name.
+func Named(name string) *Logger {
+	return std.Named(name)
+}
+
+// SetOutput sets the output destination of the standard logger.
+func SetOutput(w io.Writer) {
+	std.SetOutput(w)
+}
+
+// SetFlags sets the output flags of the standard logger.
+func SetFlags(flag int) {
+	std.SetFlags(flag)
+}
+
+// SetPrefix sets the output prefix of the standard logger.
+func SetPrefix(prefix string) {
+	std.SetPrefix(prefix)
+}
+
+// SetLevel sets the most verbose level of the standard logger.
+func SetLevel(level string) error {
+	return std.SetLevel(level)
+}
+
+// SetVModule sets the levels of the named loggers and of the source files with
+// a comma separated list of pattern=level rules:
+//
+//	db=trace,http/*=info,server.go=debug
+//
+// The patterns ending with ".go" match the file of the call, against as many
+// trailing path elements as the pattern has, and the others match the name of
+// the logger. The patterns use the path.Match syntax. A rule matching the file
+// takes precedence over one matching the name, and the first matching rule of
+// each kind wins. The rules override the level set by SetLevel, but they can't
+// enable the levels disabled by the build tags. An empty spec removes the
+// rules.
+func SetVModule(spec string) error {
+	rules, err := parseVModule(spec)
+	if err != nil {
+		return err
+	}
+
+	vmodule.Store(rules)
+	return nil
+}
+
+// vmodule are the rules set by SetVModule, nil if there are none.
+var vmodule atomic.Pointer[vmoduleRules]
+
+type vmoduleRule struct {
+	pattern string
+	file    bool
+	limit   int32
+}
+
+type vmoduleRules struct {
+	rules     []vmoduleRule
+	fileRules bool
+
+	// names and pcs cache the limits of the logger names and the call sites,
+	// -1 if no rule matches.
+	names sync.Map
+	pcs   sync.Map
+}
+
+func parseVModule(spec string) (*vmoduleRules, error) {
+	result := &vmoduleRules{}
+
+	for _, rule := range strings.Split(spec, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+
+		i := strings.LastIndex(rule, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid rule %q, the rules are pattern=level", rule)
+		}
+
+		pattern := rule[:i]
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+
+		limit, err := levelLimit(rule[i+1:])
+		if err != nil {
+			return nil, err
+		}
+
+		isFile := strings.HasSuffix(pattern, ".go")
+		result.fileRules = result.fileRules || isFile
+		result.rules = append(result.rules, vmoduleRule{pattern: pattern, file: isFile, limit: limit})
+	}
+
+	if len(result.rules) == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// limit returns the limit of the given logger set by the rules for the call
+// site at calldepth, false if no rule matches.
+func (v *vmoduleRules) limit(name string, calldepth int) (int32, bool) {
+	if v.fileRules {
+		var pcs [1]uintptr
+		if runtime.Callers(calldepth+1, pcs[:]) == 1 {
+			limit, cached := v.pcs.Load(pcs[0])
+			if !cached {
+				frame, _ := runtime.CallersFrames(pcs[:]).Next()
+				limit, _ = v.pcs.LoadOrStore(pcs[0], v.match(frame.File, true))
+			}
+
+			if limit.(int32) != -1 {
+				return limit.(int32), true
+			}
+		}
+	}
+
+	limit, cached := v.names.Load(name)
+	if !cached {
+		limit, _ = v.names.LoadOrStore(name, v.match(name, false))
+	}
+
+	return limit.(int32), limit.(int32) != -1
+}
+
+// match returns the limit of the first file or name rule matching s, -1 if
+// none matches.
+func (v *vmoduleRules) match(s string, file bool) int32 {
+	for _, rule := range v.rules {
+		if rule.file != file {
+			continue
+		}
+
+		target := s
+		if file {
+			elems := strings.Split(s, "/")
+			if n := strings.Count(rule.pattern, "/") + 1; len(elems) > n {
+				elems = elems[len(elems)-n:]
+			}
+			target = strings.Join(elems, "/")
+		}
+
+		if matched, _ := path.Match(rule.pattern, target); matched {
+			return rule.limit
+		}
+	}
+
+	return -1
+}
+
+// SetLabel sets the label of the given level of the standard logger.
+func SetLabel(level, label string) {
+	std.SetLabel(level, label)
+}
+
+// SetColor sets when the level labels of the standard logger are colored.
+func SetColor(mode ColorMode) {
+	std.SetColor(mode)
+}
+
+// SetLayout sets the header layout of the standard logger.
+func SetLayout(fields ...HeaderField) {
+	std.SetLayout(fields...)
+}
+
+// SetEncoder sets the encoder of the standard logger.
+func SetEncoder(encoder Encoder) {
+	std.SetEncoder(encoder)
+}
+
+// Flusher is implemented by the outputs that buffer their writes, such as
+// bufio.Writer. The output of a logger is flushed before the Fatal functions
+// exit and before the Panic functions panic.
+type Flusher interface {
+	Flush() error
+}
+
+// exit is the exit configuration of the Fatal functions.
+var exit = struct {
+	sync.Mutex
+	fn       func(code int)
+	code     int
+	handlers []func()
+}{fn: os.Exit, code: 1}
+
+// SetExitFunc sets the function called by the Fatal functions to exit the
+// program, os.Exit by default. The Fatal functions return if it returns, so
+// the code logging fatal entries can be tested.
+func SetExitFunc(fn func(code int)) {
+	exit.Lock()
+	defer exit.Unlock()
+
+	exit.fn = fn
+}
+
+// SetExitCode sets the exit code of the Fatal functions, 1 by default.
+func SetExitCode(code int) {
+	exit.Lock()
+	defer exit.Unlock()
+
+	exit.code = code
+}
+
+// RegisterExitHandler adds a handler called by the Fatal functions before
+// exiting, the handlers are called in the order they were registered. A
+// handler that panics doesn't prevent the exit.
+func RegisterExitHandler(handler func()) {
+	exit.Lock()
+	defer exit.Unlock()
+
+	exit.handlers = append(exit.handlers, handler)
+}
+
+// runExitHandler calls the given exit handler, recovering its panic.
+func runExitHandler(handler func()) {
+	defer func() {
+		if err := recover(); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "log: exit handler panicked: %v\n", err)
+		}
+	}()
+
+	handler()
+}
+
 // Panic level
 
+func PanicEnabled() bool {
+	return true
+}
+
 func Panic(args ...interface{}) {
-	std.Panic(args...)
+	std.panic(fmt.Sprint(args...), nil)
 }
 
 func Panicf(format string, args ...interface{}) {
-	std.Panicf(format, args...)
+	std.panic(fmt.Sprintf(format, args...), nil)
 }
 
 func Panicln(args ...interface{}) {
-	std.Panicln(args...)
+	std.panic(fmt.Sprintln(args...), nil)
 }
 
 func Panicfn(fn func() []interface{}) {
-	std.Panic(fn()...)
+	std.panic(fmt.Sprint(fn()...), nil)
+}
+
+func Panicw(msg string, kv ...interface{}) {
+	std.panic(msg, kv)
 }
 
 // Fatal level
 
+func FatalEnabled() bool {
+	return true
+}
+
 func Fatal(args ...interface{}) {
-	std.Fatal(args...)
+	std.fatal(fmt.Sprint(args...), nil)
 }
 
 func Fatalf(format string, args ...interface{}) {
-	Fatal(fmt.Sprintf(format, args...))
+	std.fatal(fmt.Sprintf(format, args...), nil)
 }
 
 func Fatalln(args ...interface{}) {
-	Fatal(fmt.Sprintln(args...))
+	std.fatal(fmt.Sprintln(args...), nil)
 }
 
 func Fatalfn(fn func() []interface{}) {
-	Fatal(fn()...)
+	std.fatal(fmt.Sprint(fn()...), nil)
+}
+
+func Fatalw(msg string, kv ...interface{}) {
+	std.fatal(msg, kv)
 }
 
 // Error level
 
+func ErrorEnabled() bool {
+	return true
+}
+
 func Error(args ...interface{}) {
-	std.Error(args...)
+	if !std.enabled(2, "error") {
+		return
+	}
+
+	std.output(2, "error", fmt.Sprint(args...), nil)
 }
 
 func Errorf(format string, args ...interface{}) {
-	Error(fmt.Sprintf(format, args...))
+	if !std.enabled(2, "error") {
+		return
+	}
+
+	std.output(2, "error", fmt.Sprintf(format, args...), nil)
 }
 
 func Errorln(args ...interface{}) {
-	Error(fmt.Sprintln(args...))
+	if !std.enabled(2, "error") {
+		return
+	}
+
+	std.output(2, "error", fmt.Sprintln(args...), nil)
 }
 
 func Errorfn(fn func() []interface{}) {
-	Error(fn()...)
+	if !std.enabled(2, "error") {
+		return
+	}
+
+	std.output(2, "error", fmt.Sprint(fn()...), nil)
+}
+
+func Errorw(msg string, kv ...interface{}) {
+	if !std.enabled(2, "error") {
+		return
+	}
+
+	std.output(2, "error", msg, kv)
 }
 
 // Warn level
 
+func WarnEnabled() bool {
+	return true
+}
+
 func Warn(args ...interface{}) {
-	std.Warn(args...)
+	if !std.enabled(2, "warn") {
+		return
+	}
+
+	std.output(2, "warn", fmt.Sprint(args...), nil)
 }
 
 func Warnf(format string, args ...interface{}) {
-	Warn(fmt.Sprintf(format, args...))
+	if !std.enabled(2, "warn") {
+		return
+	}
+
+	std.output(2, "warn", fmt.Sprintf(format, args...), nil)
 }
 
 func Warnln(args ...interface{}) {
-	Warn(fmt.Sprintln(args...))
+	if !std.enabled(2, "warn") {
+		return
+	}
+
+	std.output(2, "warn", fmt.Sprintln(args...), nil)
 }
 
 func Warnfn(fn func() []interface{}) {
-	Warn(fn()...)
+	if !std.enabled(2, "warn") {
+		return
+	}
+
+	std.output(2, "warn", fmt.Sprint(fn()...), nil)
+}
+
+func Warnw(msg string, kv ...interface{}) {
+	if !std.enabled(2, "warn") {
+		return
+	}
+
+	std.output(2, "warn", msg, kv)
 }
 
 // Info level
 
+func InfoEnabled() bool {
+	return true
+}
+
 func Info(args ...interface{}) {
-	std.Info(args...)
+	if !std.enabled(2, "info") {
+		return
+	}
+
+	std.output(2, "info", fmt.Sprint(args...), nil)
 }
 
 func Infof(format string, args ...interface{}) {
-	Info(fmt.Sprintf(format, args...))
+	if !std.enabled(2, "info") {
+		return
+	}
+
+	std.output(2, "info", fmt.Sprintf(format, args...), nil)
 }
 
 func Infoln(args ...interface{}) {
-	Info(fmt.Sprintln(args...))
+	if !std.enabled(2, "info") {
+		return
+	}
+
+	std.output(2, "info", fmt.Sprintln(args...), nil)
 }
 
 func Infofn(fn func() []interface{}) {
-	Info(fn()...)
-}
+	if !std.enabled(2, "info") {
+		return
+	}
 
-// Debug level
+	std.output(2, "info", fmt.Sprint(fn()...), nil)
+}
 
-func Debug(args ...interface{}) {
+func Infow(msg string, kv ...interface{}) {
+	if !std.enabled(2, "info") {
+		return
+	}
 
+	std.output(2, "info", msg, kv)
 }
 
-func Debugf(format string, args ...interface{}) {
+// Debug level
 
-}
+func DebugEnabled() bool { return false }
 
-func Debugln(args ...interface{}) {
+func Debug(args ...interface{}) {}
 
-}
+func Debugf(format string, args ...interface{}) {}
 
-func Debugfn(fn func() []interface{}) {
+func Debugln(args ...interface{}) {}
 
-}
+func Debugfn(fn func() []interface{}) {}
+
+func Debugw(msg string, kv ...interface{}) {}
 
 // Trace level
 
-func Trace(args ...interface{}) {
+func TraceEnabled() bool { return false }
 
-}
+func Trace(args ...interface{}) {}
 
-func Tracef(format string, args ...interface{}) {
+func Tracef(format string, args ...interface{}) {}
 
-}
+func Traceln(args ...interface{}) {}
 
-func Traceln(args ...interface{}) {
+func Tracefn(fn func() []interface{}) {}
 
-}
+func Tracew(msg string, kv ...interface{}) {}
 
-func Tracefn(fn func() []interface{}) {
+// Logger
 
-}
+// SetLabel sets the label of the entries of the given level, it defaults to the
+// upper case level name in brackets (e.g. "[INFO]").
+func (l *Logger) SetLabel(level, label string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-// Logger
+	if l.labels == nil {
+		l.labels = make(map[string]string)
+	}
+	l.labels[level] = label
+}
+
+// SetColor sets when the level labels are colored, they're colored when the
+// output is a terminal by default.
+func (l *Logger) SetColor(mode ColorMode) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.color = mode
+}
+
+// SetLayout sets the fields of the header of the entries, in order. The time,
+// caller and prefix fields are formatted according to the flags and the prefix
+// of the logger (see SetFlags and SetPrefix), DefaultLayout is used if no
+// field is given.
+func (l *Logger) SetLayout(fields ...HeaderField) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.layout = DefaultLayout
+	if len(fields) != 0 {
+		l.layout = append([]HeaderField(nil), fields...)
+	}
+}
+
+// SetLevel sets the most verbose level of the logger, the entries of the more
+// verbose levels are discarded. It can't enable the levels disabled by the
+// build tags. The level of the existing children of the logger is unchanged,
+// the children created afterwards start with the new level.
+func (l *Logger) SetLevel(level string) error {
+	limit, err := levelLimit(level)
+	if err != nil {
+		return err
+	}
+
+	l.limit.Store(limit)
+	return nil
+}
+
+// levelLimit returns the number of levels up to the given one.
+func levelLimit(level string) (int32, error) {
+	for i, name := range levels {
+		if strings.EqualFold(name, level) {
+			return int32(i + 1), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown level %q, the levels are: %v", level, strings.Join(levels, ", "))
+}
+
+// enabled reports whether the given level is enabled at runtime. calldepth is
+// the number of frames to skip to find the caller, 1 is the caller of enabled.
+func (l *Logger) enabled(calldepth int, level string) bool {
+	limit := l.limit.Load()
+	if rules := vmodule.Load(); rules != nil {
+		if ruleLimit, matched := rules.limit(l.name, calldepth+l.skip+1); matched {
+			limit = ruleLimit
+		}
+	}
+
+	if limit == 0 {
+		return true
+	}
+
+	for i := int32(0); i < limit && int(i) < len(levels); i++ {
+		if levels[i] == level {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetEncoder sets the encoder of the entries, TextEncoder is used by default.
+func (l *Logger) SetEncoder(encoder Encoder) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.encoder = encoder
+}
+
+// defaultColors are the ANSI colors of the default levels.
+var defaultColors = map[string]string{
+	"panic": "35",
+	"fatal": "31",
+	"error": "31",
+	"warn":  "33",
+	"info":  "32",
+	"debug": "36",
+	"trace": "90",
+}
+
+// levelColors are the ANSI colors of the levels, the other levels have the
+// color of the previous default level.
+var levelColors = func() map[string]string {
+	colors := make(map[string]string, len(levels))
+	color := defaultColors["panic"]
+	for _, level := range levels {
+		if c, isDefault := defaultColors[level]; isDefault {
+			color = c
+		}
+		colors[level] = color
+	}
+
+	return colors
+}()
+
+// panic writes a panic entry and panics with the message, the runtime level
+// only prevents writing the entry. Every logging function calls output, panic
+// or fatal directly so the caller is always two frames up.
+func (l *Logger) panic(msg string, kv []interface{}) {
+	if l.enabled(3, "panic") {
+		l.output(3, "panic", msg, kv)
+	}
+
+	l.flush()
+	panic(msg)
+}
+
+// fatal writes a fatal entry, calls the exit handlers, flushes the output and
+// exits the program. The runtime level only prevents writing the entry.
+func (l *Logger) fatal(msg string, kv []interface{}) {
+	if l.enabled(3, "fatal") {
+		l.output(3, "fatal", msg, kv)
+	}
+
+	exit.Lock()
+	fn, code, handlers := exit.fn, exit.code, exit.handlers
+	exit.Unlock()
+
+	for _, handler := range handlers {
+		runExitHandler(handler)
+	}
+
+	l.flush()
+	fn(code)
+}
+
+// flush flushes the output of the logger if it's a Flusher.
+func (l *Logger) flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if flusher, isFlusher := l.internal.Writer().(Flusher); isFlusher {
+		_ = flusher.Flush()
+	}
+}
+
+// output writes an entry of the given level. calldepth is the number of
+// frames to skip to find the caller, 1 is the caller of output.
+func (l *Logger) output(calldepth int, level, msg string, kv []interface{}) {
+	entry := Entry{
+		Flags:   l.internal.Flags(),
+		Prefix:  l.internal.Prefix(),
+		Level:   level,
+		Name:    l.name,
+		Message: strings.TrimSuffix(msg, "\n"),
+		Fields:  fields(append(l.kv[:len(l.kv):len(l.kv)], kv...)),
+	}
+
+	if entry.Flags&(log.Ldate|log.Ltime|log.Lmicroseconds) != 0 {
+		entry.Time = time.Now()
+		if entry.Flags&log.LUTC != 0 {
+			entry.Time = entry.Time.UTC()
+		}
+	}
+
+	if entry.Flags&(log.Lshortfile|log.Llongfile|Lfunction) != 0 {
+		frame := l.caller(calldepth + 1)
+		if entry.Flags&(log.Lshortfile|log.Llongfile) != 0 {
+			file := frame.File
+			if entry.Flags&log.Lshortfile != 0 {
+				file = file[strings.LastIndex(file, "/")+1:]
+			}
+			entry.Caller = file + ":" + strconv.Itoa(frame.Line)
+		}
+		if entry.Flags&Lfunction != 0 {
+			entry.Function = frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.Label = l.label(level)
+	entry.Layout = l.layout
+
+	if buf := l.encoder.Encode(nil, &entry); len(buf) != 0 {
+		_, _ = l.internal.Writer().Write(buf)
+	}
+}
+
+// caller returns the frame of the caller at calldepth, 1 is the caller of
+// caller, plus the frames skipped by the logger. The frames of the inlined
+// calls are counted.
+func (l *Logger) caller(calldepth int) runtime.Frame {
+	var pcs [1]uintptr
+	if runtime.Callers(calldepth+l.skip+1, pcs[:]) == 0 {
+		return runtime.Frame{File: "???", Function: "???"}
+	}
+
+	frame, _ := runtime.CallersFrames(pcs[:]).Next()
+	return frame
+}
+
+// label returns the label of the given level, colored if enabled.
+func (l *Logger) label(level string) string {
+	label, ok := l.labels[level]
+	if !ok {
+		label = "[" + strings.ToUpper(level) + "]"
+	}
+
+	color, hasColor := levelColors[level]
+	if !hasColor || l.color == ColorNever || (l.color == ColorAuto && !l.terminal) {
+		return label
+	}
+
+	return "\x1b[" + color + "m" + label + "\x1b[0m"
+}
 
 // Panic level
 
 func (l *Logger) Panic(args ...interface{}) {
-	l.internal.Panic(args...)
+	l.panic(fmt.Sprint(args...), nil)
 }
 
 func (l *Logger) Panicf(format string, args ...interface{}) {
-	l.internal.Panicf(format, args...)
+	l.panic(fmt.Sprintf(format, args...), nil)
 }
 
 func (l *Logger) Panicln(args ...interface{}) {
-	l.internal.Panicln(args...)
+	l.panic(fmt.Sprintln(args...), nil)
 }
 
 func (l *Logger) Panicfn(fn func() []interface{}) {
-	l.internal.Panic(fn()...)
+	l.panic(fmt.Sprint(fn()...), nil)
+}
+
+func (l *Logger) Panicw(msg string, kv ...interface{}) {
+	l.panic(msg, kv)
 }
 
 // Fatal level
 
 func (l *Logger) Fatal(args ...interface{}) {
-	l.internal.Fatal(args...)
+	l.fatal(fmt.Sprint(args...), nil)
 }
 
 func (l *Logger) Fatalf(format string, args ...interface{}) {
-	l.Fatal(fmt.Sprintf(format, args...))
+	l.fatal(fmt.Sprintf(format, args...), nil)
 }
 
 func (l *Logger) Fatalln(args ...interface{}) {
-	l.Fatal(fmt.Sprintln(args...))
+	l.fatal(fmt.Sprintln(args...), nil)
 }
 
 func (l *Logger) Fatalfn(fn func() []interface{}) {
-	l.Fatal(fn()...)
+	l.fatal(fmt.Sprint(fn()...), nil)
+}
+
+func (l *Logger) Fatalw(msg string, kv ...interface{}) {
+	l.fatal(msg, kv)
 }
 
 // Error level
 
 func (l *Logger) Error(args ...interface{}) {
-	l.internal.Print(args...)
+	if !l.enabled(2, "error") {
+		return
+	}
+
+	l.output(2, "error", fmt.Sprint(args...), nil)
 }
 
 func (l *Logger) Errorf(format string, args ...interface{}) {
-	l.Error(fmt.Sprintf(format, args...))
+	if !l.enabled(2, "error") {
+		return
+	}
+
+	l.output(2, "error", fmt.Sprintf(format, args...), nil)
 }
 
 func (l *Logger) Errorln(args ...interface{}) {
-	l.Error(fmt.Sprintln(args...))
+	if !l.enabled(2, "error") {
+		return
+	}
+
+	l.output(2, "error", fmt.Sprintln(args...), nil)
 }
 
 func (l *Logger) Errorfn(fn func() []interface{}) {
-	l.Error(fn()...)
+	if !l.enabled(2, "error") {
+		return
+	}
+
+	l.output(2, "error", fmt.Sprint(fn()...), nil)
+}
+
+func (l *Logger) Errorw(msg string, kv ...interface{}) {
+	if !l.enabled(2, "error") {
+		return
+	}
+
+	l.output(2, "error", msg, kv)
 }
 
 // Warn level
 
 func (l *Logger) Warn(args ...interface{}) {
-	l.internal.Print(args...)
+	if !l.enabled(2, "warn") {
+		return
+	}
+
+	l.output(2, "warn", fmt.Sprint(args...), nil)
 }
 
 func (l *Logger) Warnf(format string, args ...interface{}) {
-	l.Warn(fmt.Sprintf(format, args...))
+	if !l.enabled(2, "warn") {
+		return
+	}
+
+	l.output(2, "warn", fmt.Sprintf(format, args...), nil)
 }
 
 func (l *Logger) Warnln(args ...interface{}) {
-	l.Warn(fmt.Sprintln(args...))
+	if !l.enabled(2, "warn") {
+		return
+	}
+
+	l.output(2, "warn", fmt.Sprintln(args...), nil)
 }
 
 func (l *Logger) Warnfn(fn func() []interface{}) {
-	l.Warn(fn()...)
+	if !l.enabled(2, "warn") {
+		return
+	}
+
+	l.output(2, "warn", fmt.Sprint(fn()...), nil)
+}
+
+func (l *Logger) Warnw(msg string, kv ...interface{}) {
+	if !l.enabled(2, "warn") {
+		return
+	}
+
+	l.output(2, "warn", msg, kv)
 }
 
 // Info level
 
 func (l *Logger) Info(args ...interface{}) {
-	l.internal.Print(args...)
+	if !l.enabled(2, "info") {
+		return
+	}
+
+	l.output(2, "info", fmt.Sprint(args...), nil)
 }
 
 func (l *Logger) Infof(format string, args ...interface{}) {
-	l.Info(fmt.Sprintf(format, args...))
+	if !l.enabled(2, "info") {
+		return
+	}
+
+	l.output(2, "info", fmt.Sprintf(format, args...), nil)
 }
 
 func (l *Logger) Infoln(args ...interface{}) {
-	l.Info(fmt.Sprintln(args...))
-}
+	if !l.enabled(2, "info") {
+		return
+	}
 
-func (l *Logger) Infofn(fn func() []interface{}) {
-	l.Info(fn()...)
+	l.output(2, "info", fmt.Sprintln(args...), nil)
 }
 
-// Debug level
-
-func (l *Logger) Debug(args ...interface{}) {
+func (l *Logger) Infofn(fn func() []interface{}) {
+	if !l.enabled(2, "info") {
+		return
+	}
 
+	l.output(2, "info", fmt.Sprint(fn()...), nil)
 }
 
-func (l *Logger) Debugf(format string, args ...interface{}) {
+func (l *Logger) Infow(msg string, kv ...interface{}) {
+	if !l.enabled(2, "info") {
+		return
+	}
 
+	l.output(2, "info", msg, kv)
 }
 
-func (l *Logger) Debugln(args ...interface{}) {
-
-}
+// Debug level
 
-func (l *Logger) Debugfn(fn func() []interface{}) {
+func (l *Logger) Debug(args ...interface{}) {}
 
-}
+func (l *Logger) Debugf(format string, args ...interface{}) {}
 
-// Trace level
+func (l *Logger) Debugln(args ...interface{}) {}
 
-func (l *Logger) Trace(args ...interface{}) {
+func (l *Logger) Debugfn(fn func() []interface{}) {}
 
-}
+func (l *Logger) Debugw(msg string, kv ...interface{}) {}
 
-func (l *Logger) Tracef(format string, args ...interface{}) {
+// Trace level
 
-}
+func (l *Logger) Trace(args ...interface{}) {}
 
-func (l *Logger) Traceln(args ...interface{}) {
+func (l *Logger) Tracef(format string, args ...interface{}) {}
 
-}
+func (l *Logger) Traceln(args ...interface{}) {}
 
-func (l *Logger) Tracefn(fn func() []interface{}) {
+func (l *Logger) Tracefn(fn func() []interface{}) {}
 
-}
+func (l *Logger) Tracew(msg string, kv ...interface{}) {}
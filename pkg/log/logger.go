@@ -3,24 +3,154 @@ package log
 import (
 	"io"
 	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// Lfunction is a flag that adds the name of the calling function to the
+// entries, after their file and line (see HeaderCaller). It's set with
+// SetFlags like the flags of the standard library logger.
+const Lfunction = log.Lmsgprefix << 1
+
+// HeaderField is a field of the header of the log entries.
+type HeaderField int
+
+const (
+	// HeaderPrefix is the prefix of the logger, it's written before the
+	// message instead if the Lmsgprefix flag is set.
+	HeaderPrefix HeaderField = iota
+	// HeaderTime is the date and time of the entry, as set by the Ldate, Ltime,
+	// Lmicroseconds and LUTC flags.
+	HeaderTime
+	// HeaderCaller is the file, line and function of the call, as set by the
+	// Lshortfile, Llongfile and Lfunction flags.
+	HeaderCaller
+	// HeaderLevel is the label of the level of the entry.
+	HeaderLevel
+	// HeaderName is the name of the logger, see Named.
+	HeaderName
+)
+
+// DefaultLayout is the default header layout: the header of the standard
+// library logger followed by the level label and the logger name.
+var DefaultLayout = []HeaderField{HeaderPrefix, HeaderTime, HeaderCaller, HeaderLevel, HeaderName}
+
+// ColorMode defines when the level labels are colored.
+type ColorMode int
+
+const (
+	// ColorAuto colors the labels when the output is a terminal.
+	ColorAuto ColorMode = iota
+	// ColorAlways always colors the labels.
+	ColorAlways
+	// ColorNever never colors the labels.
+	ColorNever
 )
 
 type Logger struct {
+	*logger
+
+	// name is the name of the logger, see Named.
+	name string
+	// skip is the number of frames skipped to find the caller, see CallerSkip.
+	skip int
+	// kv are the key/value pairs added to every entry, see With.
+	kv []interface{}
+	// limit is the number of levels enabled at runtime, all the levels are
+	// enabled if it's zero. It's copied to the children, see SetLevel.
+	limit atomic.Int32
+}
+
+// logger is the output and the settings shared by a Logger and its children.
+type logger struct {
 	internal *log.Logger
+
+	mu       sync.Mutex
+	labels   map[string]string
+	color    ColorMode
+	layout   []HeaderField
+	encoder  Encoder
+	terminal bool
 }
 
+// defaultLimit is the runtime level limit of the new loggers, it's set at
+// init by the DEBUGGO_LOG_LEVEL environment variable.
+var defaultLimit int32
+
 // New creates a new Logger. The out variable sets the destination to which log data will be written.
 // The prefix appears at the beginning of each generated log line, or after the log header if the Lmsgprefix flag is
 // provided. The flag argument defines the logging properties.
 func New(out io.Writer, prefix string, flag int) *Logger {
-	return &Logger{
-		log.New(out, prefix, flag),
+	l := &Logger{
+		logger: &logger{
+			internal: log.New(out, prefix, flag),
+			layout:   DefaultLayout,
+			encoder:  TextEncoder{},
+			terminal: isTerminal(out),
+		},
 	}
+	l.limit.Store(defaultLimit)
+
+	return l
+}
+
+// With returns a child logger that adds the given key/value pairs to every
+// entry. The child shares the output and the settings of l, and starts with
+// its level.
+func (l *Logger) With(kv ...interface{}) *Logger {
+	return l.child(l.name, l.skip, append(l.kv[:len(l.kv):len(l.kv)], kv...))
+}
+
+// Named returns a child logger whose name is written in the entries, the name
+// of the child of a named logger is "parent/name". The child shares the output
+// and the settings of l and starts with its level, which can be set by
+// SetLevel or the DEBUGGO_LOG_VMODULE environment variable (see SetVModule).
+func (l *Logger) Named(name string) *Logger {
+	if l.name != "" {
+		name = l.name + "/" + name
+	}
+
+	return l.child(name, l.skip, l.kv)
+}
+
+// CallerSkip returns a child logger that skips the given number of additional
+// frames to find the caller of its entries. It's used by the helpers wrapping
+// a logger, so the entries point at the callers of the helper:
+//
+//	var logger = log.Named("db").CallerSkip(1)
+//
+//	func logQuery(query string) {
+//		logger.Debugw("query", "sql", query)
+//	}
+//
+// The child shares the output and the settings of l, and starts with its
+// level.
+func (l *Logger) CallerSkip(skip int) *Logger {
+	return l.child(l.name, l.skip+skip, l.kv)
+}
+
+// child returns a logger sharing the output and the settings of l, with the
+// current runtime level of l.
+func (l *Logger) child(name string, skip int, kv []interface{}) *Logger {
+	child := &Logger{
+		logger: l.logger,
+		name:   name,
+		skip:   skip,
+		kv:     kv,
+	}
+	child.limit.Store(l.limit.Load())
+
+	return child
 }
 
 // SetOutput sets the output destination for the logger.
 func (l *Logger) SetOutput(w io.Writer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	l.internal.SetOutput(w)
+	l.terminal = isTerminal(w)
 }
 
 // SetFlags sets the output flags for the logger.
@@ -33,3 +163,14 @@ func (l *Logger) SetFlags(flag int) {
 func (l *Logger) SetPrefix(prefix string) {
 	l.internal.SetPrefix(prefix)
 }
+
+// isTerminal reports whether w is a character device, such as a terminal.
+func isTerminal(w io.Writer) bool {
+	file, isFile := w.(*os.File)
+	if !isFile {
+		return false
+	}
+
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
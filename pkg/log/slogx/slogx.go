@@ -0,0 +1,176 @@
+// Package slogx connects log/slog with the debuggo log package.
+//
+// Handler disables the slog levels that are disabled by the build tags of the
+// log package, the slog.Debug calls return before building their record when
+// the debug level is disabled. Encoder and NewLogger emit the entries of a
+// log.Logger through a slog.Handler.
+package slogx
+
+import (
+	"context"
+	"io"
+	stdlog "log"
+	"log/slog"
+
+	"github.com/negrel/debuggo/pkg/log"
+)
+
+// LevelTrace is the slog level of the trace level of the log package.
+const LevelTrace = slog.LevelDebug - 4
+
+// Enabled reports whether the given slog level is enabled by the build tags of
+// the log package. It's the state of the most severe level of the log package
+// whose slog level is below or equal to the given one, of the most verbose
+// level if there is none.
+func Enabled(level slog.Level) bool {
+	if len(levels) == 0 {
+		return false
+	}
+
+	for _, l := range levels {
+		if l.level <= level {
+			return log.Enabled(l.name)
+		}
+	}
+
+	return log.Enabled(levels[len(levels)-1].name)
+}
+
+// Handler is a slog.Handler that forwards the records of the levels enabled
+// by the build tags of the log package to another handler.
+type Handler struct {
+	handler slog.Handler
+}
+
+// NewHandler returns a Handler forwarding the enabled records to handler.
+func NewHandler(handler slog.Handler) *Handler {
+	return &Handler{handler: handler}
+}
+
+// Enabled implements the slog.Handler interface.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return Enabled(level) && h.handler.Enabled(ctx, level)
+}
+
+// Handle implements the slog.Handler interface.
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	return h.handler.Handle(ctx, record)
+}
+
+// WithAttrs implements the slog.Handler interface.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &Handler{handler: h.handler.WithAttrs(attrs)}
+}
+
+// WithGroup implements the slog.Handler interface.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{handler: h.handler.WithGroup(name)}
+}
+
+// defaultLevels are the slog levels of the default levels of the log package.
+var defaultLevels = map[string]slog.Level{
+	"panic": slog.LevelError + 8,
+	"fatal": slog.LevelError + 4,
+	"error": slog.LevelError,
+	"warn":  slog.LevelWarn,
+	"info":  slog.LevelInfo,
+	"debug": slog.LevelDebug,
+	"trace": LevelTrace,
+}
+
+type level struct {
+	name  string
+	level slog.Level
+}
+
+// levels are the levels of the log package with their slog level, from the
+// most to the least severe.
+var levels = slogLevels(log.Levels())
+
+// slogLevels returns the given levels, from the most to the least severe, with
+// their slog level. The custom levels are spread between the default levels
+// around them, or 4 apart past the first or last default level.
+func slogLevels(names []string) []level {
+	result := make([]level, len(names))
+	known := make([]int, 0, len(names))
+	for i, name := range names {
+		result[i].name = name
+		if l, isDefault := defaultLevels[name]; isDefault {
+			result[i].level = l
+			known = append(known, i)
+		}
+	}
+
+	if len(known) == 0 {
+		for i := range result {
+			result[i].level = slog.LevelError - slog.Level(4*i)
+		}
+		return result
+	}
+
+	for i := 0; i < known[0]; i++ {
+		result[i].level = result[known[0]].level + slog.Level(4*(known[0]-i))
+	}
+	for k := 1; k < len(known); k++ {
+		prev, next := known[k-1], known[k]
+		for i := prev + 1; i < next; i++ {
+			step := (result[next].level - result[prev].level) * slog.Level(i-prev) / slog.Level(next-prev)
+			result[i].level = result[prev].level + step
+		}
+	}
+	last := known[len(known)-1]
+	for i := last + 1; i < len(result); i++ {
+		result[i].level = result[last].level - slog.Level(4*(i-last))
+	}
+
+	return result
+}
+
+// Level returns the slog level of the given level of the log package, unknown
+// levels are mapped to slog.LevelInfo.
+func Level(name string) slog.Level {
+	for _, l := range levels {
+		if l.name == name {
+			return l.level
+		}
+	}
+
+	return slog.LevelInfo
+}
+
+type encoder struct {
+	handler slog.Handler
+}
+
+// Encoder returns a log.Encoder that hands the entries to the given handler
+// instead of writing them to the output of the logger. The time, message and
+// fields of the entries are kept, their prefix and header layout are ignored.
+func Encoder(handler slog.Handler) log.Encoder {
+	return encoder{handler: handler}
+}
+
+// Encode implements the log.Encoder interface.
+func (e encoder) Encode(buf []byte, entry *log.Entry) []byte {
+	ctx := context.Background()
+	level := Level(entry.Level)
+	if !e.handler.Enabled(ctx, level) {
+		return buf
+	}
+
+	record := slog.NewRecord(entry.Time, level, entry.Message, 0)
+	for _, field := range entry.Fields {
+		record.AddAttrs(slog.Any(field.Key, field.Value))
+	}
+	_ = e.handler.Handle(ctx, record)
+
+	return buf
+}
+
+// NewLogger returns a log.Logger that emits its entries through the given
+// handler.
+func NewLogger(handler slog.Handler) *log.Logger {
+	logger := log.New(io.Discard, "", stdlog.LstdFlags)
+	logger.SetEncoder(Encoder(handler))
+
+	return logger
+}